@@ -1,9 +1,12 @@
 package okex
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // GetBalanceService get account balance
@@ -91,10 +94,11 @@ type Balance struct {
 
 // GetPositionsService
 type GetPositionsService struct {
-	c        *Client
-	instType *string
-	instId   *string
-	posId    *string
+	c          *Client
+	instType   *string
+	instFamily *string
+	instId     *string
+	posId      *string
 }
 
 // Set Instrument Type
@@ -103,6 +107,12 @@ func (s *GetPositionsService) InstrumentType(instType string) *GetPositionsServi
 	return s
 }
 
+// Set instrument family, e.g. BTC-USD, replaces the underlying
+func (s *GetPositionsService) InstrumentFamily(instFamily string) *GetPositionsService {
+	s.instFamily = &instFamily
+	return s
+}
+
 // Set Instrument Id
 func (s *GetPositionsService) InstrumentId(instId string) *GetPositionsService {
 	s.instId = &instId
@@ -126,6 +136,9 @@ func (s *GetPositionsService) Do(ctx context.Context, opts ...RequestOption) (re
 	if s.instType != nil {
 		r.setParam("instType", *s.instType)
 	}
+	if s.instFamily != nil {
+		r.setParam("instFamily", *s.instFamily)
+	}
 	if s.instId != nil {
 		r.setParam("instId", *s.instId)
 	}
@@ -270,23 +283,41 @@ type GetAccountConfigurationServiceResponse struct {
 }
 
 type AccountConfiguration struct {
-	Uid        string `json:"udi"`
-	AcctLv     string `json:"acctLc"`
-	PosMode    string `json:"posMode"`
-	AutoLoan   bool   `json:"autoLoan"`
-	GreeksType string `json:"greeksType"`
-	Level      string `json:"level"`
-	LevelTmp   string `json:"levelTmp"`
+	Uid        string       `json:"uid"`
+	AcctLv     AccountLevel `json:"acctLv"`
+	PosMode    PositionMode `json:"posMode"`
+	AutoLoan   bool         `json:"autoLoan"`
+	GreeksType GreeksType   `json:"greeksType"`
+	Level      string       `json:"level"`
+	LevelTmp   string       `json:"levelTmp"`
 }
 
+// AccountLevel define the account mode
+type AccountLevel string
+
+const (
+	AccountLevelSimple          AccountLevel = "1"
+	AccountLevelSingleCcyMargin AccountLevel = "2"
+	AccountLevelMultiCcyMargin  AccountLevel = "3"
+	AccountLevelPortfolioMargin AccountLevel = "4"
+)
+
+// PositionMode define the position mode of FUTURES and SWAP
+type PositionMode string
+
+const (
+	PositionModeLongShort PositionMode = "long_short_mode"
+	PositionModeNet       PositionMode = "net_mode"
+)
+
 // SetAccountPositionModeService
 type SetAccountPositionModeService struct {
 	c       *Client
-	posMode string
+	posMode PositionMode
 }
 
 // Set pos Mode 'long_short_mode' or 'net_mode'
-func (s *SetAccountPositionModeService) PosMode(posMode string) *SetAccountPositionModeService {
+func (s *SetAccountPositionModeService) PosMode(posMode PositionMode) *SetAccountPositionModeService {
 	s.posMode = posMode
 	return s
 }
@@ -299,7 +330,7 @@ func (s *SetAccountPositionModeService) Do(ctx context.Context, opts ...RequestO
 		secType:  secTypeSigned,
 	}
 
-	r.setBodyParam("posMode", s.posMode)
+	r.setBodyParam("posMode", string(s.posMode))
 
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -315,9 +346,349 @@ func (s *SetAccountPositionModeService) Do(ctx context.Context, opts ...RequestO
 
 // Response to SetAccountPositionModeServiceService
 type SetAccountPositionModeServiceResponse struct {
-	Code string         `json:"code"`
-	Data []*interface{} `json:"data"`
-	Msg  string         `json:"msg"`
+	Code string                `json:"code"`
+	Data []*PositionModeDetail `json:"data"`
+	Msg  string                `json:"msg"`
+}
+
+type PositionModeDetail struct {
+	PosMode PositionMode `json:"posMode"`
+}
+
+// SetAccountLevelService switch the account mode
+type SetAccountLevelService struct {
+	c      *Client
+	acctLv AccountLevel
+}
+
+// Set Account Level
+func (s *SetAccountLevelService) AccountLevel(acctLv AccountLevel) *SetAccountLevelService {
+	s.acctLv = acctLv
+	return s
+}
+
+// Do send request
+func (s *SetAccountLevelService) Do(ctx context.Context, opts ...RequestOption) (res *SetAccountLevelServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/account/set-account-level",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("acctLv", string(s.acctLv))
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SetAccountLevelServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to SetAccountLevelService
+type SetAccountLevelServiceResponse struct {
+	Code string                `json:"code"`
+	Data []*AccountLevelDetail `json:"data"`
+	Msg  string                `json:"msg"`
+}
+
+type AccountLevelDetail struct {
+	AcctLv AccountLevel `json:"acctLv"`
+}
+
+// SetAutoLoanService enable or disable automatic borrowing in multi-currency and portfolio margin modes
+type SetAutoLoanService struct {
+	c        *Client
+	autoLoan bool
+}
+
+// Set Auto Loan
+func (s *SetAutoLoanService) AutoLoan(autoLoan bool) *SetAutoLoanService {
+	s.autoLoan = autoLoan
+	return s
+}
+
+// Do send request
+func (s *SetAutoLoanService) Do(ctx context.Context, opts ...RequestOption) (res *SetAutoLoanServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/account/set-auto-loan",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("autoLoan", strconv.FormatBool(s.autoLoan))
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SetAutoLoanServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to SetAutoLoanService
+type SetAutoLoanServiceResponse struct {
+	Code string            `json:"code"`
+	Data []*AutoLoanDetail `json:"data"`
+	Msg  string            `json:"msg"`
+}
+
+type AutoLoanDetail struct {
+	AutoLoan bool `json:"autoLoan"`
+}
+
+// SetLeverageService
+type SetLeverageService struct {
+	c       *Client
+	instId  *string
+	ccy     *string
+	lever   string
+	mgnMode TradeMode
+	posSide *PositionSideType
+}
+
+// Set Instrument, either instId or ccy is required
+func (s *SetLeverageService) InstrumentId(instId string) *SetLeverageService {
+	s.instId = &instId
+	return s
+}
+
+// Set Currency, used for cross MARGIN of single-currency or multi-currency margin accounts
+func (s *SetLeverageService) Currency(ccy string) *SetLeverageService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set Leverage
+func (s *SetLeverageService) Leverage(lever string) *SetLeverageService {
+	s.lever = lever
+	return s
+}
+
+// Set Margin Mode, isolated or cross
+func (s *SetLeverageService) MarginMode(mgnMode TradeMode) *SetLeverageService {
+	s.mgnMode = mgnMode
+	return s
+}
+
+// Set Position Side, only required for isolated margin in long/short mode
+func (s *SetLeverageService) PositionSide(posSide PositionSideType) *SetLeverageService {
+	s.posSide = &posSide
+	return s
+}
+
+// Do send request
+func (s *SetLeverageService) Do(ctx context.Context, opts ...RequestOption) (res *SetLeverageServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/account/set-leverage",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("lever", s.lever)
+	r.setBodyParam("mgnMode", string(s.mgnMode))
+
+	if s.instId != nil {
+		r.setBodyParam("instId", *s.instId)
+	}
+	if s.ccy != nil {
+		r.setBodyParam("ccy", *s.ccy)
+	}
+	if s.posSide != nil {
+		r.setBodyParam("posSide", string(*s.posSide))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SetLeverageServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to SetLeverageService
+type SetLeverageServiceResponse struct {
+	Code string            `json:"code"`
+	Data []*LeverageDetail `json:"data"`
+	Msg  string            `json:"msg"`
+}
+
+// MarginBalanceType define the direction of an isolated margin adjustment
+type MarginBalanceType string
+
+const (
+	MarginBalanceTypeAdd    MarginBalanceType = "add"
+	MarginBalanceTypeReduce MarginBalanceType = "reduce"
+)
+
+// AdjustMarginBalanceService increase or decrease the margin of an isolated position
+type AdjustMarginBalanceService struct {
+	c         *Client
+	instId    string
+	posSide   PositionSideType
+	typ       MarginBalanceType
+	amt       string
+	ccy       *string
+	auto      *bool
+	loanTrans *bool
+}
+
+// Set Instrument
+func (s *AdjustMarginBalanceService) InstrumentId(instId string) *AdjustMarginBalanceService {
+	s.instId = instId
+	return s
+}
+
+// Set Position Side, long or short in long/short mode, net otherwise
+func (s *AdjustMarginBalanceService) PositionSide(posSide PositionSideType) *AdjustMarginBalanceService {
+	s.posSide = posSide
+	return s
+}
+
+// Set Type, add or reduce
+func (s *AdjustMarginBalanceService) Type(typ MarginBalanceType) *AdjustMarginBalanceService {
+	s.typ = typ
+	return s
+}
+
+// Set Amount
+func (s *AdjustMarginBalanceService) Amount(amt string) *AdjustMarginBalanceService {
+	s.amt = amt
+	return s
+}
+
+// Set Currency, only for isolated MARGIN orders
+func (s *AdjustMarginBalanceService) Currency(ccy string) *AdjustMarginBalanceService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set Auto, automatic loan transfer out when reducing margin
+func (s *AdjustMarginBalanceService) Auto(auto bool) *AdjustMarginBalanceService {
+	s.auto = &auto
+	return s
+}
+
+// Set Loan Transfer, whether or not borrowed coins can be transferred out
+func (s *AdjustMarginBalanceService) LoanTransfer(loanTrans bool) *AdjustMarginBalanceService {
+	s.loanTrans = &loanTrans
+	return s
+}
+
+// Do send request
+func (s *AdjustMarginBalanceService) Do(ctx context.Context, opts ...RequestOption) (res *AdjustMarginBalanceServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/account/position/margin-balance",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("instId", s.instId)
+	r.setBodyParam("posSide", string(s.posSide))
+	r.setBodyParam("type", string(s.typ))
+	r.setBodyParam("amt", s.amt)
+
+	if s.ccy != nil {
+		r.setBodyParam("ccy", *s.ccy)
+	}
+	if s.auto != nil {
+		r.setBodyParam("auto", strconv.FormatBool(*s.auto))
+	}
+	if s.loanTrans != nil {
+		r.setBodyParam("loanTrans", strconv.FormatBool(*s.loanTrans))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AdjustMarginBalanceServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to AdjustMarginBalanceService
+type AdjustMarginBalanceServiceResponse struct {
+	Code string                 `json:"code"`
+	Data []*MarginBalanceDetail `json:"data"`
+	Msg  string                 `json:"msg"`
+}
+
+type MarginBalanceDetail struct {
+	InstId   string `json:"instId"`
+	PosSide  string `json:"posSide"`
+	Amt      string `json:"amt"`
+	Type     string `json:"type"`
+	Leverage string `json:"leverage"`
+	Ccy      string `json:"ccy"`
+}
+
+// SetIsolatedModeService set the isolated margin trading mode
+type SetIsolatedModeService struct {
+	c       *Client
+	isoMode string
+	typ     string
+}
+
+// Set Isolated Mode, 'automatic' or 'autonomy'
+func (s *SetIsolatedModeService) IsolatedMode(isoMode string) *SetIsolatedModeService {
+	s.isoMode = isoMode
+	return s
+}
+
+// Set Instrument Type, 'MARGIN' or 'CONTRACTS'
+func (s *SetIsolatedModeService) Type(typ string) *SetIsolatedModeService {
+	s.typ = typ
+	return s
+}
+
+// Do send request
+func (s *SetIsolatedModeService) Do(ctx context.Context, opts ...RequestOption) (res *SetIsolatedModeServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/account/set-isolated-mode",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("isoMode", s.isoMode)
+	r.setBodyParam("type", s.typ)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SetIsolatedModeServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to SetIsolatedModeService
+type SetIsolatedModeServiceResponse struct {
+	Code string                `json:"code"`
+	Data []*IsolatedModeDetail `json:"data"`
+	Msg  string                `json:"msg"`
+}
+
+type IsolatedModeDetail struct {
+	IsoMode string `json:"isoMode"`
 }
 
 // GetLeverageService get account balance
@@ -446,3 +817,1557 @@ type MaxLoan struct {
 	Ccy     string `json:"ccy"`
 	Side    string `json:"side"`
 }
+
+// GetMaximumSizeService get the maximum quantity to buy or sell
+type GetMaximumSizeService struct {
+	c        *Client
+	instId   string
+	tdMode   TradeMode
+	ccy      *string
+	px       *string
+	leverage *string
+}
+
+// Set Instrument, up to 5 comma separated instruments of the same type
+func (s *GetMaximumSizeService) InstrumentId(instId string) *GetMaximumSizeService {
+	s.instId = instId
+	return s
+}
+
+// Set Trade Mode
+func (s *GetMaximumSizeService) TradeMode(tdMode TradeMode) *GetMaximumSizeService {
+	s.tdMode = tdMode
+	return s
+}
+
+// Set Currency, margin currency for isolated MARGIN orders
+func (s *GetMaximumSizeService) Currency(ccy string) *GetMaximumSizeService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set Price, the last traded price is used if not set
+func (s *GetMaximumSizeService) Price(px string) *GetMaximumSizeService {
+	s.px = &px
+	return s
+}
+
+// Set Leverage, the current leverage is used if not set
+func (s *GetMaximumSizeService) Leverage(leverage string) *GetMaximumSizeService {
+	s.leverage = &leverage
+	return s
+}
+
+// Do send request
+func (s *GetMaximumSizeService) Do(ctx context.Context, opts ...RequestOption) (res *GetMaximumSizeServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/max-size",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("instId", s.instId)
+	r.setParam("tdMode", string(s.tdMode))
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.px != nil {
+		r.setParam("px", *s.px)
+	}
+	if s.leverage != nil {
+		r.setParam("leverage", *s.leverage)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetMaximumSizeServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetMaximumSizeService
+type GetMaximumSizeServiceResponse struct {
+	Code string     `json:"code"`
+	Data []*MaxSize `json:"data"`
+	Msg  string     `json:"msg"`
+}
+
+type MaxSize struct {
+	InstId  string `json:"instId"`
+	Ccy     string `json:"ccy"`
+	MaxBuy  string `json:"maxBuy"`
+	MaxSell string `json:"maxSell"`
+}
+
+// GetMaximumAvailableSizeService get the maximum available tradable amount
+type GetMaximumAvailableSizeService struct {
+	c          *Client
+	instId     string
+	tdMode     TradeMode
+	ccy        *string
+	reduceOnly *bool
+}
+
+// Set Instrument, up to 5 comma separated instruments of the same type
+func (s *GetMaximumAvailableSizeService) InstrumentId(instId string) *GetMaximumAvailableSizeService {
+	s.instId = instId
+	return s
+}
+
+// Set Trade Mode
+func (s *GetMaximumAvailableSizeService) TradeMode(tdMode TradeMode) *GetMaximumAvailableSizeService {
+	s.tdMode = tdMode
+	return s
+}
+
+// Set Currency, margin currency for isolated MARGIN orders
+func (s *GetMaximumAvailableSizeService) Currency(ccy string) *GetMaximumAvailableSizeService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set ReduceOnly, only for MARGIN orders in cross mode
+func (s *GetMaximumAvailableSizeService) ReduceOnly(reduceOnly bool) *GetMaximumAvailableSizeService {
+	s.reduceOnly = &reduceOnly
+	return s
+}
+
+// Do send request
+func (s *GetMaximumAvailableSizeService) Do(ctx context.Context, opts ...RequestOption) (res *GetMaximumAvailableSizeServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/max-avail-size",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("instId", s.instId)
+	r.setParam("tdMode", string(s.tdMode))
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.reduceOnly != nil {
+		r.setParam("reduceOnly", strconv.FormatBool(*s.reduceOnly))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetMaximumAvailableSizeServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetMaximumAvailableSizeService
+type GetMaximumAvailableSizeServiceResponse struct {
+	Code string          `json:"code"`
+	Data []*MaxAvailSize `json:"data"`
+	Msg  string          `json:"msg"`
+}
+
+type MaxAvailSize struct {
+	InstId    string `json:"instId"`
+	AvailBuy  string `json:"availBuy"`
+	AvailSell string `json:"availSell"`
+}
+
+// GetMaximumWithdrawalService get the maximum transferable amount from the trading account
+type GetMaximumWithdrawalService struct {
+	c   *Client
+	ccy *string
+}
+
+// Set Currency, up to 20 comma separated currencies
+func (s *GetMaximumWithdrawalService) Currency(ccy string) *GetMaximumWithdrawalService {
+	s.ccy = &ccy
+	return s
+}
+
+// Do send request
+func (s *GetMaximumWithdrawalService) Do(ctx context.Context, opts ...RequestOption) (res *GetMaximumWithdrawalServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/max-withdrawal",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetMaximumWithdrawalServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetMaximumWithdrawalService
+type GetMaximumWithdrawalServiceResponse struct {
+	Code string           `json:"code"`
+	Data []*MaxWithdrawal `json:"data"`
+	Msg  string           `json:"msg"`
+}
+
+type MaxWithdrawal struct {
+	Ccy               string `json:"ccy"`
+	MaxWd             string `json:"maxWd"`
+	MaxWdEx           string `json:"maxWdEx"`
+	SpotOffsetMaxWd   string `json:"spotOffsetMaxWd"`
+	SpotOffsetMaxWdEx string `json:"spotOffsetMaxWdEx"`
+}
+
+// BillType define the bill type of the account ledger
+type BillType string
+
+// BillSubType define the bill sub type of the account ledger
+type BillSubType string
+
+const (
+	BillTypeTransfer              BillType = "1"
+	BillTypeTrade                 BillType = "2"
+	BillTypeDelivery              BillType = "3"
+	BillTypeAutoTokenConversion   BillType = "4"
+	BillTypeLiquidation           BillType = "5"
+	BillTypeMarginTransfer        BillType = "6"
+	BillTypeInterestDeduction     BillType = "7"
+	BillTypeFundingFee            BillType = "8"
+	BillTypeADL                   BillType = "9"
+	BillTypeClawback              BillType = "10"
+	BillTypeSystemTokenConversion BillType = "11"
+	BillTypeStrategyTransfer      BillType = "12"
+	BillTypeDDH                   BillType = "13"
+	BillTypeBlockTrade            BillType = "14"
+	BillTypeQuickMargin           BillType = "15"
+	BillTypeBorrowing             BillType = "16"
+	BillTypeRepay                 BillType = "22"
+
+	BillSubTypeBuy                          BillSubType = "1"
+	BillSubTypeSell                         BillSubType = "2"
+	BillSubTypeOpenLong                     BillSubType = "3"
+	BillSubTypeOpenShort                    BillSubType = "4"
+	BillSubTypeCloseLong                    BillSubType = "5"
+	BillSubTypeCloseShort                   BillSubType = "6"
+	BillSubTypeInterestDeduction            BillSubType = "9"
+	BillSubTypeTransferIn                   BillSubType = "11"
+	BillSubTypeTransferOut                  BillSubType = "12"
+	BillSubTypeVIPInterestDeduction         BillSubType = "14"
+	BillSubTypeManualMarginIncrease         BillSubType = "160"
+	BillSubTypeManualMarginDecrease         BillSubType = "161"
+	BillSubTypeAutoMarginIncrease           BillSubType = "162"
+	BillSubTypeAutoBuy                      BillSubType = "114"
+	BillSubTypeAutoSell                     BillSubType = "115"
+	BillSubTypePartialLiquidationCloseLong  BillSubType = "100"
+	BillSubTypePartialLiquidationCloseShort BillSubType = "101"
+	BillSubTypePartialLiquidationBuy        BillSubType = "102"
+	BillSubTypePartialLiquidationSell       BillSubType = "103"
+	BillSubTypeLiquidationLong              BillSubType = "104"
+	BillSubTypeLiquidationShort             BillSubType = "105"
+	BillSubTypeLiquidationBuy               BillSubType = "106"
+	BillSubTypeLiquidationSell              BillSubType = "107"
+	BillSubTypeLiquidationTransferIn        BillSubType = "110"
+	BillSubTypeLiquidationTransferOut       BillSubType = "111"
+	BillSubTypeDeliveryLong                 BillSubType = "112"
+	BillSubTypeDeliveryShort                BillSubType = "113"
+	BillSubTypeClawback                     BillSubType = "117"
+	BillSubTypeADLCloseLong                 BillSubType = "125"
+	BillSubTypeADLCloseShort                BillSubType = "126"
+	BillSubTypeADLBuy                       BillSubType = "127"
+	BillSubTypeADLSell                      BillSubType = "128"
+	BillSubTypeExercised                    BillSubType = "170"
+	BillSubTypeCounterpartyExercised        BillSubType = "171"
+	BillSubTypeExpiredOTM                   BillSubType = "172"
+	BillSubTypeFundingFeeExpense            BillSubType = "173"
+	BillSubTypeFundingFeeIncome             BillSubType = "174"
+)
+
+// GetBillsService get the account ledger, last 7 days with NewGetBillsService and last 3 months with NewGetBillsArchiveService
+type GetBillsService struct {
+	c        *Client
+	archive  bool
+	instType *string
+	ccy      *string
+	mgnMode  *TradeMode
+	ctType   *string
+	typ      *BillType
+	subType  *BillSubType
+	after    *string
+	before   *string
+	begin    *time.Time
+	end      *time.Time
+	limit    *string
+}
+
+// Set Instrument Type
+func (s *GetBillsService) InstrumentType(instType string) *GetBillsService {
+	s.instType = &instType
+	return s
+}
+
+// Set Currency
+func (s *GetBillsService) Currency(ccy string) *GetBillsService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set Margin Mode, isolated or cross
+func (s *GetBillsService) MarginMode(mgnMode TradeMode) *GetBillsService {
+	s.mgnMode = &mgnMode
+	return s
+}
+
+// Set Contract Type, linear or inverse
+func (s *GetBillsService) ContractType(ctType string) *GetBillsService {
+	s.ctType = &ctType
+	return s
+}
+
+// Set Bill Type
+func (s *GetBillsService) Type(typ BillType) *GetBillsService {
+	s.typ = &typ
+	return s
+}
+
+// Set Bill Sub Type
+func (s *GetBillsService) SubType(subType BillSubType) *GetBillsService {
+	s.subType = &subType
+	return s
+}
+
+// Set after, pagination of data to return records earlier than the requested billId
+func (s *GetBillsService) After(after string) *GetBillsService {
+	s.after = &after
+	return s
+}
+
+// Set before, pagination of data to return records newer than the requested billId
+func (s *GetBillsService) Before(before string) *GetBillsService {
+	s.before = &before
+	return s
+}
+
+// Set begin of the time range
+func (s *GetBillsService) Begin(begin time.Time) *GetBillsService {
+	s.begin = &begin
+	return s
+}
+
+// Set end of the time range
+func (s *GetBillsService) End(end time.Time) *GetBillsService {
+	s.end = &end
+	return s
+}
+
+// Set limit, max 100
+func (s *GetBillsService) Limit(limit string) *GetBillsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetBillsService) Do(ctx context.Context, opts ...RequestOption) (res *GetBillsServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/bills",
+		secType:  secTypeSigned,
+	}
+	if s.archive {
+		r.endpoint = "/api/v5/account/bills-archive"
+	}
+
+	if s.instType != nil {
+		r.setParam("instType", *s.instType)
+	}
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.mgnMode != nil {
+		r.setParam("mgnMode", string(*s.mgnMode))
+	}
+	if s.ctType != nil {
+		r.setParam("ctType", *s.ctType)
+	}
+	if s.typ != nil {
+		r.setParam("type", string(*s.typ))
+	}
+	if s.subType != nil {
+		r.setParam("subType", string(*s.subType))
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.begin != nil {
+		r.setParam("begin", FormatTimestamp(*s.begin))
+	}
+	if s.end != nil {
+		r.setParam("end", FormatTimestamp(*s.end))
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetBillsServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetBillsService
+type GetBillsServiceResponse struct {
+	Code string  `json:"code"`
+	Data []*Bill `json:"data"`
+	Msg  string  `json:"msg"`
+}
+
+// Bill is a ledger entry, trade related bills are linked to the order by OrdId and to the fill by TradeId
+type Bill struct {
+	BillId    string      `json:"billId"`
+	Type      BillType    `json:"type"`
+	SubType   BillSubType `json:"subType"`
+	InstType  string      `json:"instType"`
+	InstId    string      `json:"instId"`
+	Ccy       string      `json:"ccy"`
+	MgnMode   string      `json:"mgnMode"`
+	Bal       string      `json:"bal"`
+	BalChg    string      `json:"balChg"`
+	PosBal    string      `json:"posBal"`
+	PosBalChg string      `json:"posBalChg"`
+	Sz        string      `json:"sz"`
+	Px        string      `json:"px"`
+	Pnl       string      `json:"pnl"`
+	Fee       string      `json:"fee"`
+	Interest  string      `json:"interest"`
+	ExecType  string      `json:"execType"`
+	From      string      `json:"from"`
+	To        string      `json:"to"`
+	Notes     string      `json:"notes"`
+	OrdId     string      `json:"ordId"`
+	ClOrdId   string      `json:"clOrdId"`
+	TradeId   string      `json:"tradeId"`
+	Tag       string      `json:"tag"`
+	FillTime  string      `json:"fillTime"`
+	Ts        string      `json:"ts"`
+}
+
+// GetTradeFeeService get the fee rates of the account
+type GetTradeFeeService struct {
+	c          *Client
+	instType   string
+	instId     *string
+	uly        *string
+	instFamily *string
+}
+
+// Set Instrument Type
+func (s *GetTradeFeeService) InstrumentType(instType string) *GetTradeFeeService {
+	s.instType = instType
+	return s
+}
+
+// Set Instrument Id, only applicable to SPOT and MARGIN
+func (s *GetTradeFeeService) InstrumentId(instId string) *GetTradeFeeService {
+	s.instId = &instId
+	return s
+}
+
+// Set Underlying, only applicable to FUTURES, SWAP and OPTION
+func (s *GetTradeFeeService) Underlying(uly string) *GetTradeFeeService {
+	s.uly = &uly
+	return s
+}
+
+// Set Instrument Family, only applicable to FUTURES, SWAP and OPTION
+func (s *GetTradeFeeService) InstrumentFamily(instFamily string) *GetTradeFeeService {
+	s.instFamily = &instFamily
+	return s
+}
+
+// Do send request
+func (s *GetTradeFeeService) Do(ctx context.Context, opts ...RequestOption) (res *GetTradeFeeServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/trade-fee",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("instType", s.instType)
+
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+	if s.uly != nil {
+		r.setParam("uly", *s.uly)
+	}
+	if s.instFamily != nil {
+		r.setParam("instFamily", *s.instFamily)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetTradeFeeServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetTradeFeeService
+type GetTradeFeeServiceResponse struct {
+	Code string      `json:"code"`
+	Data []*TradeFee `json:"data"`
+	Msg  string      `json:"msg"`
+}
+
+// TradeFee holds the fee rates of a fee tier, negative rates are charges and positive rates are rebates
+type TradeFee struct {
+	InstType  string `json:"instType"`
+	Level     string `json:"level"`
+	Category  string `json:"category"`
+	Maker     string `json:"maker"`
+	Taker     string `json:"taker"`
+	MakerU    string `json:"makerU"`
+	TakerU    string `json:"takerU"`
+	MakerUSDC string `json:"makerUSDC"`
+	TakerUSDC string `json:"takerUSDC"`
+	Delivery  string `json:"delivery"`
+	Exercise  string `json:"exercise"`
+	Ts        string `json:"ts"`
+}
+
+// LoanSideType define the side of a borrow or repay operation
+type LoanSideType string
+
+const (
+	LoanSideBorrow LoanSideType = "borrow"
+	LoanSideRepay  LoanSideType = "repay"
+)
+
+// GetInterestAccruedService get the accrued interest records of the past year
+type GetInterestAccruedService struct {
+	c       *Client
+	typ     *string
+	ccy     *string
+	instId  *string
+	mgnMode *TradeMode
+	after   *string
+	before  *string
+	limit   *string
+}
+
+// Set Loan Type, 1 for VIP loans and 2 for market loans
+func (s *GetInterestAccruedService) Type(typ string) *GetInterestAccruedService {
+	s.typ = &typ
+	return s
+}
+
+// Set Currency
+func (s *GetInterestAccruedService) Currency(ccy string) *GetInterestAccruedService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set Instrument Id
+func (s *GetInterestAccruedService) InstrumentId(instId string) *GetInterestAccruedService {
+	s.instId = &instId
+	return s
+}
+
+// Set Margin Mode
+func (s *GetInterestAccruedService) MarginMode(mgnMode TradeMode) *GetInterestAccruedService {
+	s.mgnMode = &mgnMode
+	return s
+}
+
+// Set after
+func (s *GetInterestAccruedService) After(after string) *GetInterestAccruedService {
+	s.after = &after
+	return s
+}
+
+// Set before
+func (s *GetInterestAccruedService) Before(before string) *GetInterestAccruedService {
+	s.before = &before
+	return s
+}
+
+// Set limit
+func (s *GetInterestAccruedService) Limit(limit string) *GetInterestAccruedService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetInterestAccruedService) Do(ctx context.Context, opts ...RequestOption) (res *GetInterestAccruedServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/interest-accrued",
+		secType:  secTypeSigned,
+	}
+
+	if s.typ != nil {
+		r.setParam("type", *s.typ)
+	}
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+	if s.mgnMode != nil {
+		r.setParam("mgnMode", string(*s.mgnMode))
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetInterestAccruedServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetInterestAccruedService
+type GetInterestAccruedServiceResponse struct {
+	Code string             `json:"code"`
+	Data []*InterestAccrued `json:"data"`
+	Msg  string             `json:"msg"`
+}
+
+type InterestAccrued struct {
+	Type         string `json:"type"`
+	Ccy          string `json:"ccy"`
+	InstId       string `json:"instId"`
+	MgnMode      string `json:"mgnMode"`
+	Interest     string `json:"interest"`
+	InterestRate string `json:"interestRate"`
+	Liab         string `json:"liab"`
+	Ts           string `json:"ts"`
+}
+
+// GetInterestRateService get the user's current borrowing interest rate
+type GetInterestRateService struct {
+	c   *Client
+	ccy *string
+}
+
+// Set Currency
+func (s *GetInterestRateService) Currency(ccy string) *GetInterestRateService {
+	s.ccy = &ccy
+	return s
+}
+
+// Do send request
+func (s *GetInterestRateService) Do(ctx context.Context, opts ...RequestOption) (res *GetInterestRateServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/interest-rate",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetInterestRateServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetInterestRateService
+type GetInterestRateServiceResponse struct {
+	Code string          `json:"code"`
+	Data []*InterestRate `json:"data"`
+	Msg  string          `json:"msg"`
+}
+
+type InterestRate struct {
+	Ccy          string `json:"ccy"`
+	InterestRate string `json:"interestRate"`
+}
+
+// GetInterestLimitsService get the borrow interest and limit
+type GetInterestLimitsService struct {
+	c   *Client
+	typ *string
+	ccy *string
+}
+
+// Set Loan Type, 1 for VIP loans and 2 for market loans
+func (s *GetInterestLimitsService) Type(typ string) *GetInterestLimitsService {
+	s.typ = &typ
+	return s
+}
+
+// Set Currency
+func (s *GetInterestLimitsService) Currency(ccy string) *GetInterestLimitsService {
+	s.ccy = &ccy
+	return s
+}
+
+// Do send request
+func (s *GetInterestLimitsService) Do(ctx context.Context, opts ...RequestOption) (res *GetInterestLimitsServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/interest-limits",
+		secType:  secTypeSigned,
+	}
+
+	if s.typ != nil {
+		r.setParam("type", *s.typ)
+	}
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetInterestLimitsServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetInterestLimitsService
+type GetInterestLimitsServiceResponse struct {
+	Code string            `json:"code"`
+	Data []*InterestLimits `json:"data"`
+	Msg  string            `json:"msg"`
+}
+
+type InterestLimits struct {
+	Debt             string                 `json:"debt"`
+	Interest         string                 `json:"interest"`
+	NextDiscountTime string                 `json:"nextDiscountTime"`
+	NextInterestTime string                 `json:"nextInterestTime"`
+	LoanAlloc        string                 `json:"loanAlloc"`
+	Records          []*InterestLimitRecord `json:"records"`
+}
+
+type InterestLimitRecord struct {
+	Ccy        string `json:"ccy"`
+	Rate       string `json:"rate"`
+	LoanQuota  string `json:"loanQuota"`
+	SurplusLmt string `json:"surplusLmt"`
+	UsedLmt    string `json:"usedLmt"`
+	Interest   string `json:"interest"`
+	PosLoan    string `json:"posLoan"`
+	AvailLoan  string `json:"availLoan"`
+	UsedLoan   string `json:"usedLoan"`
+	AvgRate    string `json:"avgRate"`
+}
+
+// BorrowRepayService borrow or repay a VIP loan
+type BorrowRepayService struct {
+	c     *Client
+	ccy   string
+	side  LoanSideType
+	amt   string
+	ordId *string
+}
+
+// Set Currency
+func (s *BorrowRepayService) Currency(ccy string) *BorrowRepayService {
+	s.ccy = ccy
+	return s
+}
+
+// Set Side, borrow or repay
+func (s *BorrowRepayService) Side(side LoanSideType) *BorrowRepayService {
+	s.side = side
+	return s
+}
+
+// Set Amount
+func (s *BorrowRepayService) Amount(amt string) *BorrowRepayService {
+	s.amt = amt
+	return s
+}
+
+// Set Order Id of the loan, required when repaying
+func (s *BorrowRepayService) OrderId(ordId string) *BorrowRepayService {
+	s.ordId = &ordId
+	return s
+}
+
+// Do send request
+func (s *BorrowRepayService) Do(ctx context.Context, opts ...RequestOption) (res *BorrowRepayServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/account/borrow-repay",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("ccy", s.ccy)
+	r.setBodyParam("side", string(s.side))
+	r.setBodyParam("amt", s.amt)
+
+	if s.ordId != nil {
+		r.setBodyParam("ordId", *s.ordId)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(BorrowRepayServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to BorrowRepayService
+type BorrowRepayServiceResponse struct {
+	Code string         `json:"code"`
+	Data []*BorrowRepay `json:"data"`
+	Msg  string         `json:"msg"`
+}
+
+type BorrowRepay struct {
+	Ccy       string `json:"ccy"`
+	Side      string `json:"side"`
+	Amt       string `json:"amt"`
+	AvailLoan string `json:"availLoan"`
+	OrdId     string `json:"ordId"`
+	State     string `json:"state"`
+}
+
+// GetBorrowRepayHistoryService get the VIP loans borrow and repay history
+type GetBorrowRepayHistoryService struct {
+	c      *Client
+	ccy    *string
+	after  *string
+	before *string
+	limit  *string
+}
+
+// Set Currency
+func (s *GetBorrowRepayHistoryService) Currency(ccy string) *GetBorrowRepayHistoryService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set after
+func (s *GetBorrowRepayHistoryService) After(after string) *GetBorrowRepayHistoryService {
+	s.after = &after
+	return s
+}
+
+// Set before
+func (s *GetBorrowRepayHistoryService) Before(before string) *GetBorrowRepayHistoryService {
+	s.before = &before
+	return s
+}
+
+// Set limit
+func (s *GetBorrowRepayHistoryService) Limit(limit string) *GetBorrowRepayHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetBorrowRepayHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *GetBorrowRepayHistoryServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/borrow-repay-history",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetBorrowRepayHistoryServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetBorrowRepayHistoryService
+type GetBorrowRepayHistoryServiceResponse struct {
+	Code string                `json:"code"`
+	Data []*BorrowRepayHistory `json:"data"`
+	Msg  string                `json:"msg"`
+}
+
+type BorrowRepayHistory struct {
+	Ccy        string `json:"ccy"`
+	TradedLoan string `json:"tradedLoan"`
+	Type       string `json:"type"`
+	UsedLmt    string `json:"usedLmt"`
+	Ts         string `json:"ts"`
+}
+
+// QuickMarginBorrowRepayService borrow or repay in quick margin mode
+type QuickMarginBorrowRepayService struct {
+	c      *Client
+	instId string
+	ccy    string
+	side   LoanSideType
+	amt    string
+}
+
+// Set Instrument Id
+func (s *QuickMarginBorrowRepayService) InstrumentId(instId string) *QuickMarginBorrowRepayService {
+	s.instId = instId
+	return s
+}
+
+// Set Currency
+func (s *QuickMarginBorrowRepayService) Currency(ccy string) *QuickMarginBorrowRepayService {
+	s.ccy = ccy
+	return s
+}
+
+// Set Side, borrow or repay
+func (s *QuickMarginBorrowRepayService) Side(side LoanSideType) *QuickMarginBorrowRepayService {
+	s.side = side
+	return s
+}
+
+// Set Amount
+func (s *QuickMarginBorrowRepayService) Amount(amt string) *QuickMarginBorrowRepayService {
+	s.amt = amt
+	return s
+}
+
+// Do send request
+func (s *QuickMarginBorrowRepayService) Do(ctx context.Context, opts ...RequestOption) (res *QuickMarginBorrowRepayServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/account/quick-margin-borrow-repay",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("instId", s.instId)
+	r.setBodyParam("ccy", s.ccy)
+	r.setBodyParam("side", string(s.side))
+	r.setBodyParam("amt", s.amt)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(QuickMarginBorrowRepayServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to QuickMarginBorrowRepayService
+type QuickMarginBorrowRepayServiceResponse struct {
+	Code string                    `json:"code"`
+	Data []*QuickMarginBorrowRepay `json:"data"`
+	Msg  string                    `json:"msg"`
+}
+
+type QuickMarginBorrowRepay struct {
+	InstId string `json:"instId"`
+	Ccy    string `json:"ccy"`
+	Side   string `json:"side"`
+	Amt    string `json:"amt"`
+}
+
+// GetQuickMarginBorrowRepayHistoryService get the quick margin borrow and repay history
+type GetQuickMarginBorrowRepayHistoryService struct {
+	c      *Client
+	instId *string
+	ccy    *string
+	side   *LoanSideType
+	after  *string
+	before *string
+	begin  *time.Time
+	end    *time.Time
+	limit  *string
+}
+
+// Set Instrument Id
+func (s *GetQuickMarginBorrowRepayHistoryService) InstrumentId(instId string) *GetQuickMarginBorrowRepayHistoryService {
+	s.instId = &instId
+	return s
+}
+
+// Set Currency
+func (s *GetQuickMarginBorrowRepayHistoryService) Currency(ccy string) *GetQuickMarginBorrowRepayHistoryService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set Side, borrow or repay
+func (s *GetQuickMarginBorrowRepayHistoryService) Side(side LoanSideType) *GetQuickMarginBorrowRepayHistoryService {
+	s.side = &side
+	return s
+}
+
+// Set after, pagination of data to return records earlier than the requested refId
+func (s *GetQuickMarginBorrowRepayHistoryService) After(after string) *GetQuickMarginBorrowRepayHistoryService {
+	s.after = &after
+	return s
+}
+
+// Set before, pagination of data to return records newer than the requested refId
+func (s *GetQuickMarginBorrowRepayHistoryService) Before(before string) *GetQuickMarginBorrowRepayHistoryService {
+	s.before = &before
+	return s
+}
+
+// Set begin of the time range
+func (s *GetQuickMarginBorrowRepayHistoryService) Begin(begin time.Time) *GetQuickMarginBorrowRepayHistoryService {
+	s.begin = &begin
+	return s
+}
+
+// Set end of the time range
+func (s *GetQuickMarginBorrowRepayHistoryService) End(end time.Time) *GetQuickMarginBorrowRepayHistoryService {
+	s.end = &end
+	return s
+}
+
+// Set limit
+func (s *GetQuickMarginBorrowRepayHistoryService) Limit(limit string) *GetQuickMarginBorrowRepayHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetQuickMarginBorrowRepayHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *GetQuickMarginBorrowRepayHistoryServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/quick-margin-borrow-repay-history",
+		secType:  secTypeSigned,
+	}
+
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.side != nil {
+		r.setParam("side", string(*s.side))
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.begin != nil {
+		r.setParam("begin", FormatTimestamp(*s.begin))
+	}
+	if s.end != nil {
+		r.setParam("end", FormatTimestamp(*s.end))
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetQuickMarginBorrowRepayHistoryServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetQuickMarginBorrowRepayHistoryService
+type GetQuickMarginBorrowRepayHistoryServiceResponse struct {
+	Code string                           `json:"code"`
+	Data []*QuickMarginBorrowRepayHistory `json:"data"`
+	Msg  string                           `json:"msg"`
+}
+
+type QuickMarginBorrowRepayHistory struct {
+	InstId      string `json:"instId"`
+	Ccy         string `json:"ccy"`
+	Side        string `json:"side"`
+	AccBorrowed string `json:"accBorrowed"`
+	Amt         string `json:"amt"`
+	RefId       string `json:"refId"`
+	Ts          string `json:"ts"`
+}
+
+// GreeksType define the display type of Greeks
+type GreeksType string
+
+const (
+	GreeksTypePA GreeksType = "PA" // Greeks in coins
+	GreeksTypeBS GreeksType = "BS" // Black-Scholes Greeks in dollars
+)
+
+// GetGreeksService get the Greeks of the assets in the account
+type GetGreeksService struct {
+	c   *Client
+	ccy *string
+}
+
+// Set Currency
+func (s *GetGreeksService) Currency(ccy string) *GetGreeksService {
+	s.ccy = &ccy
+	return s
+}
+
+// Do send request
+func (s *GetGreeksService) Do(ctx context.Context, opts ...RequestOption) (res *GetGreeksServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/greeks",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetGreeksServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetGreeksService
+type GetGreeksServiceResponse struct {
+	Code string          `json:"code"`
+	Data []*GreeksDetail `json:"data"`
+	Msg  string          `json:"msg"`
+}
+
+type GreeksDetail struct {
+	Ccy     string `json:"ccy"`
+	DeltaBS string `json:"deltaBS"`
+	DeltaPA string `json:"deltaPA"`
+	GammaBS string `json:"gammaBS"`
+	GammaPA string `json:"gammaPA"`
+	ThetaBS string `json:"thetaBS"`
+	ThetaPA string `json:"thetaPA"`
+	VegaBS  string `json:"vegaBS"`
+	VegaPA  string `json:"vegaPA"`
+	Ts      string `json:"ts"`
+}
+
+// SetGreeksService set the display type of Greeks
+type SetGreeksService struct {
+	c          *Client
+	greeksType GreeksType
+}
+
+// Set Greeks Type, PA or BS
+func (s *SetGreeksService) GreeksType(greeksType GreeksType) *SetGreeksService {
+	s.greeksType = greeksType
+	return s
+}
+
+// Do send request
+func (s *SetGreeksService) Do(ctx context.Context, opts ...RequestOption) (res *SetGreeksServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/account/set-greeks",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("greeksType", string(s.greeksType))
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SetGreeksServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to SetGreeksService
+type SetGreeksServiceResponse struct {
+	Code string              `json:"code"`
+	Data []*GreeksTypeDetail `json:"data"`
+	Msg  string              `json:"msg"`
+}
+
+type GreeksTypeDetail struct {
+	GreeksType GreeksType `json:"greeksType"`
+}
+
+// SimulatedPosition is a hypothetical position used by PositionBuilderService
+type SimulatedPosition struct {
+	InstId string `json:"instId"`
+	Pos    string `json:"pos"`
+}
+
+// PositionBuilderService calculate the portfolio margin of real and hypothetical positions
+type PositionBuilderService struct {
+	c              *Client
+	inclRealPos    *bool
+	spotOffsetType *string
+	simPos         []SimulatedPosition
+}
+
+// Set Include Real Positions, true to include the current positions of the account
+func (s *PositionBuilderService) IncludeRealPositions(inclRealPos bool) *PositionBuilderService {
+	s.inclRealPos = &inclRealPos
+	return s
+}
+
+// Set Spot Offset Type, 1: spot-derivatives risk offset, 2: derivatives only, 3: spot and derivatives
+func (s *PositionBuilderService) SpotOffsetType(spotOffsetType string) *PositionBuilderService {
+	s.spotOffsetType = &spotOffsetType
+	return s
+}
+
+// Set Simulated Positions
+func (s *PositionBuilderService) SimulatedPositions(simPos []SimulatedPosition) *PositionBuilderService {
+	s.simPos = simPos
+	return s
+}
+
+// Do send request
+func (s *PositionBuilderService) Do(ctx context.Context, opts ...RequestOption) (res *PositionBuilderServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/account/position-builder",
+		secType:  secTypeSigned,
+	}
+
+	body := struct {
+		InclRealPos    *bool               `json:"inclRealPos,omitempty"`
+		SpotOffsetType *string             `json:"spotOffsetType,omitempty"`
+		SimPos         []SimulatedPosition `json:"simPos,omitempty"`
+	}{s.inclRealPos, s.spotOffsetType, s.simPos}
+	postBody, _ := json.Marshal(body)
+	r.body = bytes.NewBuffer(postBody)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(PositionBuilderServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to PositionBuilderService
+type PositionBuilderServiceResponse struct {
+	Code string                   `json:"code"`
+	Data []*PositionBuilderDetail `json:"data"`
+	Msg  string                   `json:"msg"`
+}
+
+type PositionBuilderDetail struct {
+	Eq           string                     `json:"eq"`
+	TotalImr     string                     `json:"totalImr"`
+	TotalMmr     string                     `json:"totalMmr"`
+	BorrowMmr    string                     `json:"borrowMmr"`
+	DerivMmr     string                     `json:"derivMmr"`
+	MarginRatio  string                     `json:"marginRatio"`
+	Upl          string                     `json:"upl"`
+	AcctLever    string                     `json:"acctLever"`
+	RiskUnitData []*PositionBuilderRiskUnit `json:"riskUnitData"`
+	Positions    []*PositionBuilderPosition `json:"positions"`
+	Ts           string                     `json:"ts"`
+}
+
+type PositionBuilderRiskUnit struct {
+	RiskUnit string `json:"riskUnit"`
+	IndexUsd string `json:"indexUsd"`
+	Imr      string `json:"imr"`
+	Mmr      string `json:"mmr"`
+	Mr1      string `json:"mr1"`
+	Mr2      string `json:"mr2"`
+	Mr3      string `json:"mr3"`
+	Mr4      string `json:"mr4"`
+	Mr5      string `json:"mr5"`
+	Mr6      string `json:"mr6"`
+	Mr7      string `json:"mr7"`
+	Mr8      string `json:"mr8"`
+	Mr9      string `json:"mr9"`
+	Delta    string `json:"delta"`
+	Gamma    string `json:"gamma"`
+	Theta    string `json:"theta"`
+	Vega     string `json:"vega"`
+}
+
+type PositionBuilderPosition struct {
+	InstId      string `json:"instId"`
+	InstType    string `json:"instType"`
+	Pos         string `json:"pos"`
+	AvgPx       string `json:"avgPx"`
+	NotionalUsd string `json:"notionalUsd"`
+	Delta       string `json:"delta"`
+	Gamma       string `json:"gamma"`
+	Theta       string `json:"theta"`
+	Vega        string `json:"vega"`
+	IsRealPos   bool   `json:"isRealPos"`
+}
+
+// GetRiskStateService get the risk state of a portfolio margin account
+type GetRiskStateService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetRiskStateService) Do(ctx context.Context, opts ...RequestOption) (res *GetRiskStateServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/risk-state",
+		secType:  secTypeSigned,
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetRiskStateServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetRiskStateService
+type GetRiskStateServiceResponse struct {
+	Code string       `json:"code"`
+	Data []*RiskState `json:"data"`
+	Msg  string       `json:"msg"`
+}
+
+type RiskState struct {
+	AtRisk    bool     `json:"atRisk"`
+	AtRiskIdx []string `json:"atRiskIdx"`
+	AtRiskMgn []string `json:"atRiskMgn"`
+	Ts        string   `json:"ts"`
+}
+
+// PositionCloseType define how a position was closed
+type PositionCloseType string
+
+const (
+	PositionCloseTypeClosePartially     PositionCloseType = "1"
+	PositionCloseTypeCloseAll           PositionCloseType = "2"
+	PositionCloseTypeLiquidation        PositionCloseType = "3"
+	PositionCloseTypePartialLiquidation PositionCloseType = "4"
+	PositionCloseTypeADL                PositionCloseType = "5"
+)
+
+// GetPositionsHistoryService get the updated positions of the last 3 months
+type GetPositionsHistoryService struct {
+	c        *Client
+	instType *string
+	instId   *string
+	mgnMode  *TradeMode
+	typ      *PositionCloseType
+	posId    *string
+	after    *string
+	before   *string
+	limit    *string
+}
+
+// Set Instrument Type
+func (s *GetPositionsHistoryService) InstrumentType(instType string) *GetPositionsHistoryService {
+	s.instType = &instType
+	return s
+}
+
+// Set Instrument Id
+func (s *GetPositionsHistoryService) InstrumentId(instId string) *GetPositionsHistoryService {
+	s.instId = &instId
+	return s
+}
+
+// Set Margin Mode
+func (s *GetPositionsHistoryService) MarginMode(mgnMode TradeMode) *GetPositionsHistoryService {
+	s.mgnMode = &mgnMode
+	return s
+}
+
+// Set Close Type
+func (s *GetPositionsHistoryService) Type(typ PositionCloseType) *GetPositionsHistoryService {
+	s.typ = &typ
+	return s
+}
+
+// Set Position Id
+func (s *GetPositionsHistoryService) PositionId(posId string) *GetPositionsHistoryService {
+	s.posId = &posId
+	return s
+}
+
+// Set after, pagination of data to return records earlier than the requested uTime in ms
+func (s *GetPositionsHistoryService) After(after string) *GetPositionsHistoryService {
+	s.after = &after
+	return s
+}
+
+// Set before, pagination of data to return records newer than the requested uTime in ms
+func (s *GetPositionsHistoryService) Before(before string) *GetPositionsHistoryService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 100
+func (s *GetPositionsHistoryService) Limit(limit string) *GetPositionsHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetPositionsHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *GetPositionsHistoryServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/positions-history",
+		secType:  secTypeSigned,
+	}
+
+	if s.instType != nil {
+		r.setParam("instType", *s.instType)
+	}
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+	if s.mgnMode != nil {
+		r.setParam("mgnMode", string(*s.mgnMode))
+	}
+	if s.typ != nil {
+		r.setParam("type", string(*s.typ))
+	}
+	if s.posId != nil {
+		r.setParam("posId", *s.posId)
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetPositionsHistoryServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetPositionsHistoryService
+type GetPositionsHistoryServiceResponse struct {
+	Code string             `json:"code"`
+	Data []*PositionHistory `json:"data"`
+	Msg  string             `json:"msg"`
+}
+
+// PositionHistory is a closed or partially closed position
+type PositionHistory struct {
+	InstType      string            `json:"instType"`
+	InstId        string            `json:"instId"`
+	Uly           string            `json:"uly"`
+	Ccy           string            `json:"ccy"`
+	MgnMode       string            `json:"mgnMode"`
+	Type          PositionCloseType `json:"type"`
+	PosId         string            `json:"posId"`
+	PosSide       string            `json:"posSide"`
+	Direction     string            `json:"direction"`
+	Lever         string            `json:"lever"`
+	OpenAvgPx     string            `json:"openAvgPx"`
+	CloseAvgPx    string            `json:"closeAvgPx"`
+	OpenMaxPos    string            `json:"openMaxPos"`
+	CloseTotalPos string            `json:"closeTotalPos"`
+	Pnl           string            `json:"pnl"`
+	PnlRatio      string            `json:"pnlRatio"`
+	RealizedPnl   string            `json:"realizedPnl"`
+	Fee           string            `json:"fee"`
+	FundingFee    string            `json:"fundingFee"`
+	LiqPenalty    string            `json:"liqPenalty"`
+	TriggerPx     string            `json:"triggerPx"`
+	CTime         string            `json:"cTime"`
+	UTime         string            `json:"uTime"`
+}
@@ -0,0 +1,297 @@
+package okex
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	. "github.com/tbtc-bot/go-okex/common"
+)
+
+// Batch sizes and rate limits of the cancel endpoints used by CancelAll
+const (
+	cancelBatchOrdersMax  = 20
+	cancelAlgoOrdersMax   = 10
+	cancelRequestsPerRate = 20
+	cancelRateWindow      = 2 * time.Second
+	cancelAllWorkers      = 4
+	pendingOrdersPageSize = 100
+)
+
+// regular algo types are cancelled with cancel-algos, advance ones with cancel-advance-algos
+var (
+	cancelAllAlgoTypes        = []OrderType{OrderTypeConditional, OrderTypeOCO, OrderTypeTrigger, OrderTypeMoveStop}
+	cancelAllAdvanceAlgoTypes = []OrderType{OrderTypeIceberg, OrderTypeTwap}
+)
+
+// CancelAllFilter selects the pending orders cancelled by CancelAll, empty fields match everything
+type CancelAllFilter struct {
+	InstType string
+	InstId   string
+	Side     SideType
+	Tag      string
+	// SkipAlgo leaves algo orders untouched
+	SkipAlgo bool
+}
+
+// CancelAllItem is the outcome of the cancellation of a single order
+type CancelAllItem struct {
+	InstId string
+	OrdId  string
+	AlgoId string
+	SCode  string
+	SMsg   string
+	Err    error
+}
+
+// CancelAllResult reports which orders were cancelled and which were not
+type CancelAllResult struct {
+	Succeeded []*CancelAllItem
+	Failed    []*CancelAllItem
+}
+
+type cancelAllBatch struct {
+	orders []CancelOrder
+	algos  []CancelAlgoOrder
+	// advance marks algos to be sent to cancel-advance-algos
+	advance bool
+}
+
+// CancelAll lists the pending regular and algo orders matching filter and cancels them
+// in batches, sending up to cancelAllWorkers requests in parallel within the rate limits.
+// An error is returned only if the pending orders cannot be listed; failed cancellations
+// are reported in the result.
+func (c *Client) CancelAll(ctx context.Context, filter CancelAllFilter) (*CancelAllResult, error) {
+	var batches []*cancelAllBatch
+
+	orders, err := c.pendingOrders(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(orders); i += cancelBatchOrdersMax {
+		end := i + cancelBatchOrdersMax
+		if end > len(orders) {
+			end = len(orders)
+		}
+		batches = append(batches, &cancelAllBatch{orders: orders[i:end]})
+	}
+
+	if !filter.SkipAlgo {
+		for _, advance := range []bool{false, true} {
+			ordTypes := cancelAllAlgoTypes
+			if advance {
+				ordTypes = cancelAllAdvanceAlgoTypes
+			}
+			var algos []CancelAlgoOrder
+			for _, ordType := range ordTypes {
+				found, err := c.pendingAlgoOrders(ctx, filter, ordType)
+				if err != nil {
+					return nil, err
+				}
+				algos = append(algos, found...)
+			}
+			for i := 0; i < len(algos); i += cancelAlgoOrdersMax {
+				end := i + cancelAlgoOrdersMax
+				if end > len(algos) {
+					end = len(algos)
+				}
+				batches = append(batches, &cancelAllBatch{algos: algos[i:end], advance: advance})
+			}
+		}
+	}
+
+	res := new(CancelAllResult)
+	var mu sync.Mutex
+	collect := func(items []*CancelAllItem) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, item := range items {
+			if item.Err == nil && item.SCode == "0" {
+				res.Succeeded = append(res.Succeeded, item)
+			} else {
+				res.Failed = append(res.Failed, item)
+			}
+		}
+	}
+
+	limiter := time.NewTicker(cancelRateWindow / cancelRequestsPerRate)
+	defer limiter.Stop()
+
+	batchC := make(chan *cancelAllBatch)
+	var wg sync.WaitGroup
+	for i := 0; i < cancelAllWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range batchC {
+				collect(c.cancelBatch(ctx, b))
+			}
+		}()
+	}
+
+	sent := 0
+dispatch:
+	for _, b := range batches {
+		select {
+		case <-ctx.Done():
+			break dispatch
+		case <-limiter.C:
+		}
+		if ctx.Err() != nil {
+			break dispatch
+		}
+		batchC <- b
+		sent++
+	}
+	close(batchC)
+	wg.Wait()
+
+	// batches left after ctx is done are not sent
+	for _, b := range batches[sent:] {
+		collect(failAll(batchItems(b), ctx.Err()))
+	}
+
+	return res, nil
+}
+
+// pendingOrders pages through orders-pending and returns the orders matching filter
+func (c *Client) pendingOrders(ctx context.Context, filter CancelAllFilter) ([]CancelOrder, error) {
+	var orders []CancelOrder
+	after := ""
+	for {
+		s := c.NewGetOrderListService().Limit(strconv.Itoa(pendingOrdersPageSize))
+		if filter.InstType != "" {
+			s.InstrumentType(filter.InstType)
+		}
+		if filter.InstId != "" {
+			s.InstrumentId(filter.InstId)
+		}
+		if after != "" {
+			s.After(after)
+		}
+		res, err := s.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if res.Code != "0" {
+			return nil, &APIError{Code: res.Code, Message: res.Msg}
+		}
+		for _, o := range res.Data {
+			if filter.Side != "" && o.Side != string(filter.Side) {
+				continue
+			}
+			if filter.Tag != "" && o.Tag != filter.Tag {
+				continue
+			}
+			ordId := o.OrdId
+			orders = append(orders, CancelOrder{InstId: o.InstId, OrdId: &ordId})
+		}
+		if len(res.Data) < pendingOrdersPageSize {
+			return orders, nil
+		}
+		after = res.Data[len(res.Data)-1].OrdId
+	}
+}
+
+// pendingAlgoOrders pages through orders-algo-pending and returns the algo orders of ordType matching filter
+func (c *Client) pendingAlgoOrders(ctx context.Context, filter CancelAllFilter, ordType OrderType) ([]CancelAlgoOrder, error) {
+	var algos []CancelAlgoOrder
+	after := ""
+	for {
+		s := c.NewGetAlgoOrderListService().OrderType(ordType).Limit(strconv.Itoa(pendingOrdersPageSize))
+		if filter.InstType != "" {
+			s.InstrumentType(filter.InstType)
+		}
+		if filter.InstId != "" {
+			s.InstrumentId(filter.InstId)
+		}
+		if after != "" {
+			s.After(after)
+		}
+		res, err := s.Do(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if res.Code != "0" {
+			return nil, &APIError{Code: res.Code, Message: res.Msg}
+		}
+		for _, o := range res.Data {
+			if filter.Side != "" && o.Side != filter.Side {
+				continue
+			}
+			if filter.Tag != "" && o.Tag != filter.Tag {
+				continue
+			}
+			algos = append(algos, CancelAlgoOrder{AlgoId: o.AlgoId, InstId: o.InstId})
+		}
+		if len(res.Data) < pendingOrdersPageSize {
+			return algos, nil
+		}
+		after = res.Data[len(res.Data)-1].AlgoId
+	}
+}
+
+// cancelBatch sends a single cancel request and maps its outcome to one item per order
+func (c *Client) cancelBatch(ctx context.Context, b *cancelAllBatch) []*CancelAllItem {
+	items := batchItems(b)
+
+	if len(b.orders) > 0 {
+		res, err := c.NewCancelMultipleOrdersService().OrderList(b.orders).Do(ctx)
+		if err != nil {
+			return failAll(items, err)
+		}
+		byId := map[string]*OrderDetail{}
+		for _, d := range res.Data {
+			byId[d.OrdId] = d
+		}
+		for _, item := range items {
+			if d, ok := byId[item.OrdId]; ok {
+				item.SCode, item.SMsg = d.SCode, d.SMsg
+			} else {
+				item.Err = &APIError{Code: res.Code, Message: res.Msg}
+			}
+		}
+		return items
+	}
+
+	var res *CancelAlgoOrderResponse
+	var err error
+	if b.advance {
+		res, err = c.NewCancelAdvanceAlgoOrderService().OrderList(b.algos).Do(ctx)
+	} else {
+		res, err = c.NewCancelAlgoOrderService().OrderList(b.algos).Do(ctx)
+	}
+	if err != nil {
+		return failAll(items, err)
+	}
+	// algo cancellations are accepted or rejected as a whole
+	for _, item := range items {
+		item.SCode, item.SMsg = res.Code, res.Msg
+		for _, d := range res.Data {
+			if d.AlgoId == item.AlgoId && d.SCode != "" {
+				item.SCode, item.SMsg = d.SCode, d.SMsg
+			}
+		}
+	}
+	return items
+}
+
+// batchItems returns one item per order of b
+func batchItems(b *cancelAllBatch) []*CancelAllItem {
+	var items []*CancelAllItem
+	for _, o := range b.orders {
+		items = append(items, &CancelAllItem{InstId: o.InstId, OrdId: *o.OrdId})
+	}
+	for _, a := range b.algos {
+		items = append(items, &CancelAllItem{InstId: a.InstId, AlgoId: a.AlgoId})
+	}
+	return items
+}
+
+func failAll(items []*CancelAllItem, err error) []*CancelAllItem {
+	for _, item := range items {
+		item.Err = err
+	}
+	return items
+}
@@ -0,0 +1,112 @@
+package okex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// cancelAllStub serves 25 pending buy orders, 2 pending sell orders and a pending conditional
+// algo order. The cancellation of order 7 is rejected.
+func cancelAllStub(t *testing.T, c *Client) (batchSizes *[]int, sentAt *[]time.Time) {
+	var mu sync.Mutex
+	batchSizes, sentAt = new([]int), new([]time.Time)
+	c.do = func(req *http.Request) (*http.Response, error) {
+		var data interface{}
+		switch req.URL.Path {
+		case "/api/v5/trade/orders-pending":
+			var orders []map[string]string
+			for i := 0; i < 27; i++ {
+				side := "buy"
+				if i >= 25 {
+					side = "sell"
+				}
+				orders = append(orders, map[string]string{"instId": "BTC-USDT", "ordId": strconv.Itoa(i), "side": side})
+			}
+			data = orders
+		case "/api/v5/trade/orders-algo-pending":
+			algos := []map[string]string{}
+			if req.URL.Query().Get("ordType") == string(OrderTypeConditional) {
+				algos = append(algos, map[string]string{"instId": "BTC-USDT", "algoId": "a1", "side": "buy"})
+			}
+			data = algos
+		case "/api/v5/trade/cancel-batch-orders", "/api/v5/trade/cancel-algos":
+			var orders []map[string]string
+			body, _ := ioutil.ReadAll(req.Body)
+			assert.NoError(t, json.Unmarshal(body, &orders))
+			mu.Lock()
+			*batchSizes = append(*batchSizes, len(orders))
+			*sentAt = append(*sentAt, time.Now())
+			mu.Unlock()
+			var results []map[string]string
+			for _, o := range orders {
+				sCode := "0"
+				if o["ordId"] == "7" {
+					sCode = "51400"
+				}
+				results = append(results, map[string]string{"ordId": o["ordId"], "algoId": o["algoId"], "sCode": sCode})
+			}
+			data = results
+		default:
+			t.Fatalf("unexpected request %s", req.URL)
+		}
+		body, _ := json.Marshal(map[string]interface{}{"code": "0", "msg": "", "data": data})
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(body))}, nil
+	}
+	return batchSizes, sentAt
+}
+
+func TestCancelAll(t *testing.T) {
+	assert := assert.New(t)
+	c := NewClient("", "", "")
+	batchSizes, sentAt := cancelAllStub(t, c)
+
+	start := time.Now()
+	res, err := c.CancelAll(context.Background(), CancelAllFilter{Side: SideTypeBuy})
+	assert.NoError(err)
+
+	// 25 buy orders in batches of 20 and 5, plus the algo order
+	assert.ElementsMatch([]int{20, 5, 1}, *batchSizes)
+	assert.Len(res.Succeeded, 25)
+	if assert.Len(res.Failed, 1) {
+		assert.Equal("7", res.Failed[0].OrdId)
+		assert.Equal("51400", res.Failed[0].SCode)
+	}
+	for _, item := range res.Succeeded {
+		assert.NotEqual("7", item.OrdId)
+	}
+
+	// one request per tick of the rate limiter
+	interval := cancelRateWindow / cancelRequestsPerRate
+	assert.True(time.Since(start) >= 3*interval)
+	for _, ts := range *sentAt {
+		assert.True(ts.Sub(start) >= interval)
+	}
+}
+
+func TestCancelAllContextDone(t *testing.T) {
+	assert := assert.New(t)
+	c := NewClient("", "", "")
+	batchSizes, _ := cancelAllStub(t, c)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	res, err := c.CancelAll(ctx, CancelAllFilter{Side: SideTypeBuy})
+	assert.NoError(err)
+
+	// nothing is sent once ctx is done, every order is reported failed
+	assert.Empty(*batchSizes)
+	assert.Empty(res.Succeeded)
+	assert.Len(res.Failed, 26)
+	for _, item := range res.Failed {
+		assert.Equal(context.Canceled, item.Err)
+	}
+}
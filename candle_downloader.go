@@ -0,0 +1,307 @@
+package okex
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"time"
+
+	. "github.com/tbtc-bot/go-okex/common"
+	. "github.com/tbtc-bot/go-okex/impl"
+)
+
+// CandleFormat define the output format of CandleDownloader
+type CandleFormat int
+
+const (
+	CandleFormatCSV CandleFormat = iota
+	CandleFormatJSONLines
+)
+
+const (
+	historyCandlesPageSize   = 100
+	historyCandlesRateLimit  = 2 * time.Second / 20
+	historyCandlesMaxRetries = 3
+	historyCandlesBar        = PERIOD_1MIN
+)
+
+var candleCSVHeader = []string{"ts", "o", "h", "l", "c", "vol", "volCcy", "volCcyQuote", "confirm"}
+
+// CandleDownloader backfills the history candles of an instrument over a time range,
+// paging backwards through /market/history-candles.
+type CandleDownloader struct {
+	c          *Client
+	instId     string
+	bar        Period
+	begin      time.Time
+	end        time.Time
+	format     CandleFormat
+	rateLimit  time.Duration
+	maxRetries int
+}
+
+// Set instrument id
+func (d *CandleDownloader) InstrumentId(instId string) *CandleDownloader {
+	d.instId = instId
+	return d
+}
+
+// Set bar size, defaults to PERIOD_1MIN
+func (d *CandleDownloader) Bar(bar Period) *CandleDownloader {
+	d.bar = bar
+	return d
+}
+
+// Set begin of the time range, inclusive
+func (d *CandleDownloader) Begin(begin time.Time) *CandleDownloader {
+	d.begin = begin
+	return d
+}
+
+// Set end of the time range, exclusive, defaults to now
+func (d *CandleDownloader) End(end time.Time) *CandleDownloader {
+	d.end = end
+	return d
+}
+
+// Set output format, defaults to CSV
+func (d *CandleDownloader) Format(format CandleFormat) *CandleDownloader {
+	d.format = format
+	return d
+}
+
+// Set minimum delay between two requests, defaults to the endpoint limit of 20 requests per 2 seconds
+func (d *CandleDownloader) RateLimit(rateLimit time.Duration) *CandleDownloader {
+	d.rateLimit = rateLimit
+	return d
+}
+
+// Set how many times missing candles are fetched again, defaults to 3, 0 disables the retries
+func (d *CandleDownloader) MaxRetries(maxRetries int) *CandleDownloader {
+	d.maxRetries = maxRetries
+	return d
+}
+
+// CandleDownloadResult reports the outcome of a download
+type CandleDownloadResult struct {
+	Written int
+	// Missing holds the open times of the candles still missing after the retries,
+	// usually periods without trading
+	Missing []time.Time
+}
+
+// Do downloads the candles of the time range and writes them to w in ascending time order
+func (d *CandleDownloader) Do(ctx context.Context, w io.Writer) (*CandleDownloadResult, error) {
+	end := d.end
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if !d.begin.Before(end) {
+		return nil, fmt.Errorf("invalid time range %s - %s", d.begin, end)
+	}
+	rateLimit := d.rateLimit
+	if rateLimit == 0 {
+		rateLimit = historyCandlesRateLimit
+	}
+
+	limiter := time.NewTicker(rateLimit)
+	defer limiter.Stop()
+
+	begin, until := FormatTimestamp(d.begin), FormatTimestamp(end)
+	candles := map[int64]*Candle{}
+	err := d.fetch(ctx, limiter, candles, begin, until)
+	if err != nil {
+		return nil, err
+	}
+
+	step := periodDuration(d.bar).Milliseconds()
+	var missing []int64
+	for i := 0; i <= d.maxRetries; i++ {
+		missing = missingCandles(candles, begin, until, step)
+		if len(missing) == 0 || i == d.maxRetries {
+			break
+		}
+		for _, gap := range candleGaps(missing, step) {
+			err = d.fetch(ctx, limiter, candles, gap[0], gap[1]+step)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	res := new(CandleDownloadResult)
+	for _, ts := range missing {
+		res.Missing = append(res.Missing, time.Unix(0, ts*int64(time.Millisecond)))
+	}
+	res.Written, err = writeCandles(w, d.format, sortedCandles(candles))
+	if err != nil {
+		return res, err
+	}
+	return res, nil
+}
+
+// fetch pages backwards from until and stores the candles opened in [begin, until)
+func (d *CandleDownloader) fetch(ctx context.Context, limiter *time.Ticker, candles map[int64]*Candle, begin, until int64) error {
+	after := until
+	for after > begin {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-limiter.C:
+		}
+
+		res, err := d.c.NewGetHistoryCandlesService().InstrumentId(d.instId).Bar(d.bar).
+			After(strconv.FormatInt(after, 10)).Limit(strconv.Itoa(historyCandlesPageSize)).Do(ctx)
+		if err != nil {
+			return err
+		}
+		if res.Code != "0" {
+			return &APIError{Code: res.Code, Message: res.Msg}
+		}
+		if len(res.Data) == 0 {
+			return nil
+		}
+
+		oldest := after
+		for _, candle := range res.Data {
+			ts, err := strconv.ParseInt(candle.Ts, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid candle timestamp %q: %w", candle.Ts, err)
+			}
+			if ts < oldest {
+				oldest = ts
+			}
+			if ts >= begin && ts < until {
+				candles[ts] = candle
+			}
+		}
+		if oldest >= after {
+			return nil
+		}
+		after = oldest
+	}
+	return nil
+}
+
+// missingCandles returns the open times in [begin, until) without a candle. Open times are aligned
+// on the received candles, or on begin when there are none.
+func missingCandles(candles map[int64]*Candle, begin, until, step int64) []int64 {
+	if step <= 0 {
+		return nil
+	}
+	phase := begin
+	for ts := range candles {
+		phase = ts
+		break
+	}
+	first := begin + ((phase-begin)%step+step)%step
+	var missing []int64
+	for ts := first; ts < until; ts += step {
+		if _, ok := candles[ts]; !ok {
+			missing = append(missing, ts)
+		}
+	}
+	return missing
+}
+
+// candleGaps groups consecutive missing open times into [first, last] ranges
+func candleGaps(missing []int64, step int64) [][2]int64 {
+	var gaps [][2]int64
+	for _, ts := range missing {
+		if n := len(gaps); n > 0 && gaps[n-1][1]+step == ts {
+			gaps[n-1][1] = ts
+			continue
+		}
+		gaps = append(gaps, [2]int64{ts, ts})
+	}
+	return gaps
+}
+
+func sortedCandles(candles map[int64]*Candle) []*Candle {
+	keys := make([]int64, 0, len(candles))
+	for ts := range candles {
+		keys = append(keys, ts)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	sorted := make([]*Candle, 0, len(keys))
+	for _, ts := range keys {
+		sorted = append(sorted, candles[ts])
+	}
+	return sorted
+}
+
+func writeCandles(w io.Writer, format CandleFormat, candles []*Candle) (int, error) {
+	switch format {
+	case CandleFormatJSONLines:
+		enc := json.NewEncoder(w)
+		for i, candle := range candles {
+			err := enc.Encode(candle)
+			if err != nil {
+				return i, err
+			}
+		}
+	case CandleFormatCSV:
+		cw := csv.NewWriter(w)
+		err := cw.Write(candleCSVHeader)
+		if err != nil {
+			return 0, err
+		}
+		for i, c := range candles {
+			err = cw.Write([]string{c.Ts, c.Open, c.High, c.Low, c.Close, c.Vol, c.VolCcy, c.VolCcyQuote, c.Confirm})
+			if err != nil {
+				return i, err
+			}
+		}
+		cw.Flush()
+		err = cw.Error()
+		if err != nil {
+			return 0, err
+		}
+	default:
+		return 0, fmt.Errorf("unknown candle format %d", format)
+	}
+	return len(candles), nil
+}
+
+// periodDuration returns the length of a bar, 0 for months and years which have no fixed length
+func periodDuration(p Period) time.Duration {
+	switch p {
+	case PERIOD_1MIN:
+		return time.Minute
+	case PERIOD_3MIN:
+		return 3 * time.Minute
+	case PERIOD_5MIN:
+		return 5 * time.Minute
+	case PERIOD_15MIN:
+		return 15 * time.Minute
+	case PERIOD_30MIN:
+		return 30 * time.Minute
+	case PERIOD_1HOUR:
+		return time.Hour
+	case PERIOD_2HOUR:
+		return 2 * time.Hour
+	case PERIOD_4HOUR:
+		return 4 * time.Hour
+	case PERIOD_6HOUR:
+		return 6 * time.Hour
+	case PERIOD_12HOUR:
+		return 12 * time.Hour
+	case PERIOD_1DAY:
+		return 24 * time.Hour
+	case PERIOD_2DAY:
+		return 2 * 24 * time.Hour
+	case PERIOD_3DAY:
+		return 3 * 24 * time.Hour
+	case PERIOD_5DAY:
+		return 5 * 24 * time.Hour
+	case PERIOD_1WEEK:
+		return 7 * 24 * time.Hour
+	case PERIOD_NONE:
+		return time.Minute
+	}
+	return 0
+}
@@ -0,0 +1,153 @@
+package okex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/tbtc-bot/go-okex/impl"
+)
+
+func TestCandleDownloader(t *testing.T) {
+	assert := assert.New(t)
+
+	begin := time.Unix(1600000000, 0)
+	step := time.Minute.Milliseconds()
+	first := FormatTimestamp(begin)
+	// 250 one minute candles, the first, the last and the candle at index 120 are only
+	// available once the first backwards paging reached its end
+	const total, hole = 250, 120
+	holes := map[int]bool{0: true, hole: true, total - 1: true}
+	available := false
+
+	c := NewClient("", "", "")
+	c.do = func(req *http.Request) (*http.Response, error) {
+		after, err := strconv.ParseInt(req.URL.Query().Get("after"), 10, 64)
+		assert.NoError(err)
+		var rows [][]string
+		for i := total - 1; i >= 0 && len(rows) < historyCandlesPageSize; i-- {
+			ts := first + int64(i)*step
+			if ts >= after {
+				continue
+			}
+			if holes[i] && !available {
+				continue
+			}
+			rows = append(rows, []string{strconv.FormatInt(ts, 10), "1", "2", "0.5", "1.5", "10", "20", "30", "1"})
+		}
+		if len(rows) == 0 {
+			available = true
+		}
+		body, _ := json.Marshal(map[string]interface{}{"code": "0", "msg": "", "data": rows})
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(body))}, nil
+	}
+
+	var out bytes.Buffer
+	res, err := c.NewCandleDownloader().InstrumentId("BTC-USDT").Bar(PERIOD_1MIN).
+		Begin(begin).End(begin.Add(total*time.Minute)).RateLimit(time.Millisecond).Do(context.Background(), &out)
+	assert.NoError(err)
+	assert.Equal(total, res.Written)
+	assert.Empty(res.Missing)
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	assert.Equal(total+1, len(lines))
+	assert.Equal("ts,o,h,l,c,vol,volCcy,volCcyQuote,confirm", lines[0])
+	assert.Equal(fmt.Sprintf("%d,1,2,0.5,1.5,10,20,30,1", first), lines[1])
+	assert.Equal(fmt.Sprintf("%d,1,2,0.5,1.5,10,20,30,1", first+hole*step), lines[hole+1])
+	assert.Equal(fmt.Sprintf("%d,1,2,0.5,1.5,10,20,30,1", first+(total-1)*step), lines[total])
+
+	// without retries the holes are reported missing
+	available = false
+	out.Reset()
+	res, err = c.NewCandleDownloader().InstrumentId("BTC-USDT").Bar(PERIOD_1MIN).MaxRetries(0).
+		Begin(begin).End(begin.Add(total*time.Minute)).RateLimit(time.Millisecond).Do(context.Background(), &out)
+	assert.NoError(err)
+	assert.Equal(total-len(holes), res.Written)
+	assert.Equal([]time.Time{begin, begin.Add(hole * time.Minute), begin.Add((total - 1) * time.Minute)}, res.Missing)
+}
+
+func TestCandleGaps(t *testing.T) {
+	assert := assert.New(t)
+	day := (24 * time.Hour).Milliseconds()
+	tests := []struct {
+		name         string
+		candles      []int64
+		begin, until int64
+		step         int64
+		missing      []int64
+		gaps         [][2]int64
+	}{
+		{
+			name:    "gaps inside, at the start and at the end of the range",
+			candles: []int64{1, 2, 5, 6, 8},
+			begin:   0,
+			until:   10,
+			step:    1,
+			missing: []int64{0, 3, 4, 7, 9},
+			gaps:    [][2]int64{{0, 0}, {3, 4}, {7, 7}, {9, 9}},
+		},
+		{
+			name:    "open times aligned on the candles",
+			candles: []int64{25},
+			begin:   0,
+			until:   40,
+			step:    10,
+			missing: []int64{5, 15, 35},
+			gaps:    [][2]int64{{5, 15}, {35, 35}},
+		},
+		{
+			name:    "no candle at all, open times aligned on begin",
+			begin:   3,
+			until:   40,
+			step:    10,
+			missing: []int64{3, 13, 23, 33},
+			gaps:    [][2]int64{{3, 33}},
+		},
+		{
+			name: "no daily candle, bars open at UTC+8 midnight",
+			// 2021-09-01 00:00 UTC+8
+			begin:   1630425600000,
+			until:   1630425600000 + 2*day,
+			step:    day,
+			missing: []int64{1630425600000, 1630425600000 + day},
+			gaps:    [][2]int64{{1630425600000, 1630425600000 + day}},
+		},
+	}
+	for _, tt := range tests {
+		candles := map[int64]*Candle{}
+		for _, ts := range tt.candles {
+			candles[ts] = &Candle{}
+		}
+		missing := missingCandles(candles, tt.begin, tt.until, tt.step)
+		assert.Equal(tt.missing, missing, tt.name)
+		assert.Equal(tt.gaps, candleGaps(missing, tt.step), tt.name)
+	}
+}
+
+func TestCandleJSONLinesRoundTrip(t *testing.T) {
+	assert := assert.New(t)
+	candles := []*Candle{
+		{Ts: "1600000000000", Open: "1", High: "2", Low: "0.5", Close: "1.5", Vol: "10", VolCcy: "20", VolCcyQuote: "30", Confirm: "1"},
+		{Ts: "1600000060000", Open: "1.5", High: "1.7", Low: "1.4", Close: "1.6", Vol: "5", VolCcy: "8", VolCcyQuote: "12", Confirm: "0"},
+	}
+	var out bytes.Buffer
+	n, err := writeCandles(&out, CandleFormatJSONLines, candles)
+	assert.NoError(err)
+	assert.Equal(len(candles), n)
+
+	dec := json.NewDecoder(&out)
+	for _, want := range candles {
+		got := new(Candle)
+		assert.NoError(dec.Decode(got))
+		assert.Equal(want, got)
+	}
+	assert.False(dec.More())
+}
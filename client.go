@@ -68,6 +68,9 @@ type SideEffectType string
 // FuturesTransferType define futures transfer type
 type FuturesTransferType int
 
+// AlgoOrderStateType define algo order state
+type AlgoOrderStateType string
+
 // Endpoints
 const (
 	baseAPIMainURL = "https://www.okx.com"
@@ -103,6 +106,15 @@ const (
 	OrderTypeTrigger     OrderType = "trigger"
 	OrderTypeIceberg     OrderType = "iceberg"
 	OrderTypeTwap        OrderType = "twap"
+	OrderTypeMoveStop    OrderType = "move_order_stop"
+
+	// Algo order state
+	AlgoOrderStateLive               AlgoOrderStateType = "live"
+	AlgoOrderStatePause              AlgoOrderStateType = "pause"
+	AlgoOrderStatePartiallyEffective AlgoOrderStateType = "partially_effective"
+	AlgoOrderStateEffective          AlgoOrderStateType = "effective"
+	AlgoOrderStateCanceled           AlgoOrderStateType = "canceled"
+	AlgoOrderStateOrderFailed        AlgoOrderStateType = "order_failed"
 
 	signatureKey  = "signature"
 	recvWindowKey = "recvWindow"
@@ -145,6 +157,8 @@ func NewClient(apiKey, secretKey, passPhrase string) *Client {
 		Logger:     log.New(os.Stderr, "Okex-golang ", log.LstdFlags),
 		Debug:      false,
 		Simulated:  false, // True to enable simulated mode
+
+		EnableWithdrawals: false, // True to allow WithdrawalService to move funds off-exchange
 	}
 }
 
@@ -163,6 +177,9 @@ type Client struct {
 	Logger     *log.Logger
 	TimeOffset int64
 	do         doFunc
+
+	// EnableWithdrawals must be set explicitly to allow WithdrawalService requests
+	EnableWithdrawals bool
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -304,6 +321,11 @@ func (c *Client) NewGetPositionsService() *GetPositionsService {
 	return &GetPositionsService{c: c}
 }
 
+// NewGetPositionsHistoryService
+func (c *Client) NewGetPositionsHistoryService() *GetPositionsHistoryService {
+	return &GetPositionsHistoryService{c: c}
+}
+
 // NewGetAccountAndPositionRiskService
 func (c *Client) NewGetAccountAndPositionRiskService() *GetAccountAndPositionRiskService {
 	return &GetAccountAndPositionRiskService{c: c}
@@ -314,6 +336,121 @@ func (c *Client) NewGetAccountConfigurationService() *GetAccountConfigurationSer
 	return &GetAccountConfigurationService{c: c}
 }
 
+// NewSetAccountPositionModeService
+func (c *Client) NewSetAccountPositionModeService() *SetAccountPositionModeService {
+	return &SetAccountPositionModeService{c: c}
+}
+
+// NewSetAccountLevelService
+func (c *Client) NewSetAccountLevelService() *SetAccountLevelService {
+	return &SetAccountLevelService{c: c}
+}
+
+// NewSetAutoLoanService
+func (c *Client) NewSetAutoLoanService() *SetAutoLoanService {
+	return &SetAutoLoanService{c: c}
+}
+
+// NewSetLeverageService
+func (c *Client) NewSetLeverageService() *SetLeverageService {
+	return &SetLeverageService{c: c}
+}
+
+// NewAdjustMarginBalanceService
+func (c *Client) NewAdjustMarginBalanceService() *AdjustMarginBalanceService {
+	return &AdjustMarginBalanceService{c: c}
+}
+
+// NewSetIsolatedModeService
+func (c *Client) NewSetIsolatedModeService() *SetIsolatedModeService {
+	return &SetIsolatedModeService{c: c}
+}
+
+// NewGetMaximumSizeService
+func (c *Client) NewGetMaximumSizeService() *GetMaximumSizeService {
+	return &GetMaximumSizeService{c: c}
+}
+
+// NewGetMaximumAvailableSizeService
+func (c *Client) NewGetMaximumAvailableSizeService() *GetMaximumAvailableSizeService {
+	return &GetMaximumAvailableSizeService{c: c}
+}
+
+// NewGetMaximumWithdrawalService
+func (c *Client) NewGetMaximumWithdrawalService() *GetMaximumWithdrawalService {
+	return &GetMaximumWithdrawalService{c: c}
+}
+
+// NewGetBillsService
+func (c *Client) NewGetBillsService() *GetBillsService {
+	return &GetBillsService{c: c}
+}
+
+// NewGetBillsArchiveService
+func (c *Client) NewGetBillsArchiveService() *GetBillsService {
+	return &GetBillsService{c: c, archive: true}
+}
+
+// NewGetTradeFeeService
+func (c *Client) NewGetTradeFeeService() *GetTradeFeeService {
+	return &GetTradeFeeService{c: c}
+}
+
+// NewGetInterestAccruedService
+func (c *Client) NewGetInterestAccruedService() *GetInterestAccruedService {
+	return &GetInterestAccruedService{c: c}
+}
+
+// NewGetInterestRateService
+func (c *Client) NewGetInterestRateService() *GetInterestRateService {
+	return &GetInterestRateService{c: c}
+}
+
+// NewGetInterestLimitsService
+func (c *Client) NewGetInterestLimitsService() *GetInterestLimitsService {
+	return &GetInterestLimitsService{c: c}
+}
+
+// NewBorrowRepayService
+func (c *Client) NewBorrowRepayService() *BorrowRepayService {
+	return &BorrowRepayService{c: c}
+}
+
+// NewGetBorrowRepayHistoryService
+func (c *Client) NewGetBorrowRepayHistoryService() *GetBorrowRepayHistoryService {
+	return &GetBorrowRepayHistoryService{c: c}
+}
+
+// NewQuickMarginBorrowRepayService
+func (c *Client) NewQuickMarginBorrowRepayService() *QuickMarginBorrowRepayService {
+	return &QuickMarginBorrowRepayService{c: c}
+}
+
+// NewGetQuickMarginBorrowRepayHistoryService
+func (c *Client) NewGetQuickMarginBorrowRepayHistoryService() *GetQuickMarginBorrowRepayHistoryService {
+	return &GetQuickMarginBorrowRepayHistoryService{c: c}
+}
+
+// NewGetGreeksService
+func (c *Client) NewGetGreeksService() *GetGreeksService {
+	return &GetGreeksService{c: c}
+}
+
+// NewSetGreeksService
+func (c *Client) NewSetGreeksService() *SetGreeksService {
+	return &SetGreeksService{c: c}
+}
+
+// NewPositionBuilderService
+func (c *Client) NewPositionBuilderService() *PositionBuilderService {
+	return &PositionBuilderService{c: c}
+}
+
+// NewGetRiskStateService
+func (c *Client) NewGetRiskStateService() *GetRiskStateService {
+	return &GetRiskStateService{c: c}
+}
+
 // NewPlaceOrderService
 func (c *Client) NewPlaceOrderService() *PlaceOrderService {
 	return &PlaceOrderService{c: c}
@@ -354,6 +491,31 @@ func (c *Client) NewCancelAlgoOrderService() *CancelAlgoOrderService {
 	return &CancelAlgoOrderService{c: c}
 }
 
+// NewCancelAdvanceAlgoOrderService
+func (c *Client) NewCancelAdvanceAlgoOrderService() *CancelAdvanceAlgoOrderService {
+	return &CancelAdvanceAlgoOrderService{c: c}
+}
+
+// NewGetAlgoOrderListService
+func (c *Client) NewGetAlgoOrderListService() *AlgoOrderListService {
+	return &AlgoOrderListService{c: c}
+}
+
+// NewGetAlgoOrderHistoryService
+func (c *Client) NewGetAlgoOrderHistoryService() *AlgoOrderHistoryService {
+	return &AlgoOrderHistoryService{c: c}
+}
+
+// NewCancelAllAfterService
+func (c *Client) NewCancelAllAfterService() *CancelAllAfterService {
+	return &CancelAllAfterService{c: c}
+}
+
+// NewMassCancelService
+func (c *Client) NewMassCancelService() *MassCancelService {
+	return &MassCancelService{c: c}
+}
+
 // NewGetTickersService
 func (c *Client) NewGetTickersService() *GetTickersService {
 	return &GetTickersService{c: c}
@@ -364,6 +526,91 @@ func (c *Client) NewGetTickerService() *GetTickerService {
 	return &GetTickerService{c: c}
 }
 
+// NewGetOrderBookService
+func (c *Client) NewGetOrderBookService() *GetOrderBookService {
+	return &GetOrderBookService{c: c}
+}
+
+// NewGetOrderBookLiteService
+func (c *Client) NewGetOrderBookLiteService() *GetOrderBookLiteService {
+	return &GetOrderBookLiteService{c: c}
+}
+
+// NewGetCandlesService
+func (c *Client) NewGetCandlesService() *GetCandlesService {
+	return &GetCandlesService{c: c, endpoint: "/api/v5/market/candles"}
+}
+
+// NewGetHistoryCandlesService
+func (c *Client) NewGetHistoryCandlesService() *GetCandlesService {
+	return &GetCandlesService{c: c, endpoint: "/api/v5/market/history-candles"}
+}
+
+// NewGetIndexCandlesService
+func (c *Client) NewGetIndexCandlesService() *GetCandlesService {
+	return &GetCandlesService{c: c, endpoint: "/api/v5/market/index-candles"}
+}
+
+// NewGetHistoryIndexCandlesService
+func (c *Client) NewGetHistoryIndexCandlesService() *GetCandlesService {
+	return &GetCandlesService{c: c, endpoint: "/api/v5/market/history-index-candles"}
+}
+
+// NewGetMarkPriceCandlesService
+func (c *Client) NewGetMarkPriceCandlesService() *GetCandlesService {
+	return &GetCandlesService{c: c, endpoint: "/api/v5/market/mark-price-candles"}
+}
+
+// NewGetHistoryMarkPriceCandlesService
+func (c *Client) NewGetHistoryMarkPriceCandlesService() *GetCandlesService {
+	return &GetCandlesService{c: c, endpoint: "/api/v5/market/history-mark-price-candles"}
+}
+
+// NewCandleDownloader
+func (c *Client) NewCandleDownloader() *CandleDownloader {
+	return &CandleDownloader{c: c, bar: historyCandlesBar, maxRetries: historyCandlesMaxRetries}
+}
+
+// NewGetTradesService
+func (c *Client) NewGetTradesService() *GetTradesService {
+	return &GetTradesService{c: c}
+}
+
+// NewGetHistoryTradesService
+func (c *Client) NewGetHistoryTradesService() *GetHistoryTradesService {
+	return &GetHistoryTradesService{c: c}
+}
+
+// NewGetOptionFamilyTradesService
+func (c *Client) NewGetOptionFamilyTradesService() *GetOptionFamilyTradesService {
+	return &GetOptionFamilyTradesService{c: c}
+}
+
+// NewGetIndexTickersService
+func (c *Client) NewGetIndexTickersService() *GetIndexTickersService {
+	return &GetIndexTickersService{c: c}
+}
+
+// NewGetIndexComponentsService
+func (c *Client) NewGetIndexComponentsService() *GetIndexComponentsService {
+	return &GetIndexComponentsService{c: c}
+}
+
+// NewGetPlatformVolumeService
+func (c *Client) NewGetPlatformVolumeService() *GetPlatformVolumeService {
+	return &GetPlatformVolumeService{c: c}
+}
+
+// NewGetExchangeRateService
+func (c *Client) NewGetExchangeRateService() *GetExchangeRateService {
+	return &GetExchangeRateService{c: c}
+}
+
+// NewGetOpenOracleService
+func (c *Client) NewGetOpenOracleService() *GetOpenOracleService {
+	return &GetOpenOracleService{c: c}
+}
+
 // NewGetInstrumentsService
 func (c *Client) NewGetInstrumentsService() *GetInstrumentsService {
 	return &GetInstrumentsService{c: c}
@@ -379,12 +626,232 @@ func (c *Client) NewGetDeliveryExerciseHistoryService() *GetDeliveryExerciseHist
 	return &GetDeliveryExerciseHistoryService{c: c}
 }
 
+// NewGetOpenInterestService
+func (c *Client) NewGetOpenInterestService() *GetOpenInterestService {
+	return &GetOpenInterestService{c: c}
+}
+
+// NewGetFundingRateService
+func (c *Client) NewGetFundingRateService() *GetFundingRateService {
+	return &GetFundingRateService{c: c}
+}
+
+// NewGetFundingRateHistoryService
+func (c *Client) NewGetFundingRateHistoryService() *GetFundingRateHistoryService {
+	return &GetFundingRateHistoryService{c: c}
+}
+
+// NewGetPriceLimitService
+func (c *Client) NewGetPriceLimitService() *GetPriceLimitService {
+	return &GetPriceLimitService{c: c}
+}
+
+// NewGetEstimatedPriceService
+func (c *Client) NewGetEstimatedPriceService() *GetEstimatedPriceService {
+	return &GetEstimatedPriceService{c: c}
+}
+
+// NewGetMarkPriceService
+func (c *Client) NewGetMarkPriceService() *GetMarkPriceService {
+	return &GetMarkPriceService{c: c}
+}
+
+// NewGetPositionTiersService
+func (c *Client) NewGetPositionTiersService() *GetPositionTiersService {
+	return &GetPositionTiersService{c: c}
+}
+
+// NewGetDiscountRateService
+func (c *Client) NewGetDiscountRateService() *GetDiscountRateService {
+	return &GetDiscountRateService{c: c}
+}
+
+// NewGetInterestRateLoanQuotaService
+func (c *Client) NewGetInterestRateLoanQuotaService() *GetInterestRateLoanQuotaService {
+	return &GetInterestRateLoanQuotaService{c: c}
+}
+
+// NewGetVIPInterestRateLoanQuotaService
+func (c *Client) NewGetVIPInterestRateLoanQuotaService() *GetVIPInterestRateLoanQuotaService {
+	return &GetVIPInterestRateLoanQuotaService{c: c}
+}
+
+// NewGetLiquidationOrdersService
+func (c *Client) NewGetLiquidationOrdersService() *GetLiquidationOrdersService {
+	return &GetLiquidationOrdersService{c: c}
+}
+
+// NewGetInsuranceFundService
+func (c *Client) NewGetInsuranceFundService() *GetInsuranceFundService {
+	return &GetInsuranceFundService{c: c}
+}
+
+// NewGetSystemStatusService
+func (c *Client) NewGetSystemStatusService() *GetSystemStatusService {
+	return &GetSystemStatusService{c: c}
+}
+
+// NewGetOptionSummaryService
+func (c *Client) NewGetOptionSummaryService() *GetOptionSummaryService {
+	return &GetOptionSummaryService{c: c}
+}
+
+// NewConvertContractCoinService
+func (c *Client) NewConvertContractCoinService() *ConvertContractCoinService {
+	return &ConvertContractCoinService{c: c}
+}
+
+// NewGetSupportCoinService
+func (c *Client) NewGetSupportCoinService() *GetSupportCoinService {
+	return &GetSupportCoinService{c: c}
+}
+
+// NewGetTakerVolumeService
+func (c *Client) NewGetTakerVolumeService() *GetTakerVolumeService {
+	return &GetTakerVolumeService{c: c}
+}
+
+// NewGetMarginLendingRatioService get the ratio of the borrowed quote currency to the borrowed base currency
+func (c *Client) NewGetMarginLendingRatioService() *GetTradingRatioService {
+	return &GetTradingRatioService{c: c, endpoint: "/api/v5/rubik/stat/margin/loan-ratio"}
+}
+
+// NewGetLongShortAccountRatioService get the ratio of the users with long positions to the users with short positions
+func (c *Client) NewGetLongShortAccountRatioService() *GetTradingRatioService {
+	return &GetTradingRatioService{c: c, endpoint: "/api/v5/rubik/stat/contracts/long-short-account-ratio"}
+}
+
+// NewGetContractsOpenInterestVolumeService
+func (c *Client) NewGetContractsOpenInterestVolumeService() *GetOpenInterestVolumeService {
+	return &GetOpenInterestVolumeService{c: c, endpoint: "/api/v5/rubik/stat/contracts/open-interest-volume"}
+}
+
+// NewGetOptionOpenInterestVolumeService
+func (c *Client) NewGetOptionOpenInterestVolumeService() *GetOpenInterestVolumeService {
+	return &GetOpenInterestVolumeService{c: c, endpoint: "/api/v5/rubik/stat/option/open-interest-volume"}
+}
+
+// NewGetPutCallRatioService
+func (c *Client) NewGetPutCallRatioService() *GetPutCallRatioService {
+	return &GetPutCallRatioService{c: c}
+}
+
+// NewGetOpenInterestVolumeExpiryService
+func (c *Client) NewGetOpenInterestVolumeExpiryService() *GetOpenInterestVolumeExpiryService {
+	return &GetOpenInterestVolumeExpiryService{c: c}
+}
+
+// NewGetOpenInterestVolumeStrikeService
+func (c *Client) NewGetOpenInterestVolumeStrikeService() *GetOpenInterestVolumeStrikeService {
+	return &GetOpenInterestVolumeStrikeService{c: c}
+}
+
 // NewFundTransferService
 func (c *Client) NewFundTransferService() *FundTransferService {
 	return &FundTransferService{c: c}
 }
 
+// NewGetTransferStateService
+func (c *Client) NewGetTransferStateService() *GetTransferStateService {
+	return &GetTransferStateService{c: c}
+}
+
+// NewGetCurrenciesService
+func (c *Client) NewGetCurrenciesService() *GetCurrenciesService {
+	return &GetCurrenciesService{c: c}
+}
+
+// NewGetFundingBalanceService
+func (c *Client) NewGetFundingBalanceService() *GetFundingBalanceService {
+	return &GetFundingBalanceService{c: c}
+}
+
+// NewGetAssetValuationService
+func (c *Client) NewGetAssetValuationService() *GetAssetValuationService {
+	return &GetAssetValuationService{c: c}
+}
+
+// NewGetNonTradableAssetsService
+func (c *Client) NewGetNonTradableAssetsService() *GetNonTradableAssetsService {
+	return &GetNonTradableAssetsService{c: c}
+}
+
+// NewGetDepositAddressService
+func (c *Client) NewGetDepositAddressService() *GetDepositAddressService {
+	return &GetDepositAddressService{c: c}
+}
+
+// NewGetDepositHistoryService
+func (c *Client) NewGetDepositHistoryService() *GetDepositHistoryService {
+	return &GetDepositHistoryService{c: c}
+}
+
+// NewWithdrawalService
+func (c *Client) NewWithdrawalService() *WithdrawalService {
+	return &WithdrawalService{c: c}
+}
+
+// NewCancelWithdrawalService
+func (c *Client) NewCancelWithdrawalService() *CancelWithdrawalService {
+	return &CancelWithdrawalService{c: c}
+}
+
+// NewGetWithdrawalHistoryService
+func (c *Client) NewGetWithdrawalHistoryService() *GetWithdrawalHistoryService {
+	return &GetWithdrawalHistoryService{c: c}
+}
+
+// NewGetDepositWithdrawStatusService
+func (c *Client) NewGetDepositWithdrawStatusService() *GetDepositWithdrawStatusService {
+	return &GetDepositWithdrawStatusService{c: c}
+}
+
 // NewFundTransferService
 func (c *Client) NewMaximumLoanService() *GetMaximumLoanService {
 	return &GetMaximumLoanService{c: c}
 }
+
+// NewGetSubAccountListService
+func (c *Client) NewGetSubAccountListService() *GetSubAccountListService {
+	return &GetSubAccountListService{c: c}
+}
+
+// NewGetSubAccountBalanceService
+func (c *Client) NewGetSubAccountBalanceService() *GetSubAccountBalanceService {
+	return &GetSubAccountBalanceService{c: c}
+}
+
+// NewGetSubAccountFundingBalanceService
+func (c *Client) NewGetSubAccountFundingBalanceService() *GetSubAccountFundingBalanceService {
+	return &GetSubAccountFundingBalanceService{c: c}
+}
+
+// NewSubAccountTransferService
+func (c *Client) NewSubAccountTransferService() *SubAccountTransferService {
+	return &SubAccountTransferService{c: c}
+}
+
+// NewGetSubAccountBillsService
+func (c *Client) NewGetSubAccountBillsService() *GetSubAccountBillsService {
+	return &GetSubAccountBillsService{c: c}
+}
+
+// NewCreateSubAccountApiKeyService
+func (c *Client) NewCreateSubAccountApiKeyService() *CreateSubAccountApiKeyService {
+	return &CreateSubAccountApiKeyService{c: c}
+}
+
+// NewGetSubAccountApiKeyService
+func (c *Client) NewGetSubAccountApiKeyService() *GetSubAccountApiKeyService {
+	return &GetSubAccountApiKeyService{c: c}
+}
+
+// NewModifySubAccountApiKeyService
+func (c *Client) NewModifySubAccountApiKeyService() *ModifySubAccountApiKeyService {
+	return &ModifySubAccountApiKeyService{c: c}
+}
+
+// NewDeleteSubAccountApiKeyService
+func (c *Client) NewDeleteSubAccountApiKeyService() *DeleteSubAccountApiKeyService {
+	return &DeleteSubAccountApiKeyService{c: c}
+}
@@ -0,0 +1,104 @@
+package okex
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// lotEpsilon absorbs the float error of sizes that are an exact multiple of the lot size
+const lotEpsilon = 1e-9
+
+// ContractConverter converts the sizes of a swap, futures or option between contracts,
+// coins and quote notional without calling the exchange. Linear contracts are valued in
+// the base coin (e.g. 0.01 BTC for BTC-USDT-SWAP), inverse contracts in the quote currency
+// (e.g. 100 USD for BTC-USD-SWAP).
+type ContractConverter struct {
+	// size is the value of one contract, ctVal * ctMult
+	size         float64
+	inverse      bool
+	lotSz        float64
+	lotPrecision int
+}
+
+// NewContractConverter returns a converter for inst as returned by GetInstrumentsService
+func NewContractConverter(inst *InstrumentDetail) (*ContractConverter, error) {
+	switch inst.InstType {
+	case "SWAP", "FUTURES":
+		if inst.CtType != "linear" && inst.CtType != "inverse" {
+			return nil, fmt.Errorf("unsupported contract type %q of %s", inst.CtType, inst.InstId)
+		}
+	case "OPTION":
+		// options have no contract type and are valued in the base coin
+		if inst.CtType != "" && inst.CtType != "linear" && inst.CtType != "inverse" {
+			return nil, fmt.Errorf("unsupported contract type %q of %s", inst.CtType, inst.InstId)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported instrument type %q", inst.InstType)
+	}
+	ctVal, ctMult, err := contractValue(inst)
+	if err != nil {
+		return nil, err
+	}
+	lotSz, err := strconv.ParseFloat(inst.LotSz, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid lot size %q: %w", inst.LotSz, err)
+	}
+	if ctVal <= 0 || ctMult <= 0 || lotSz <= 0 {
+		return nil, fmt.Errorf("invalid contract specification of %s", inst.InstId)
+	}
+	precision := 0
+	if i := strings.IndexByte(inst.LotSz, '.'); i >= 0 {
+		precision = len(strings.TrimRight(inst.LotSz[i+1:], "0"))
+	}
+	return &ContractConverter{
+		size:         ctVal * ctMult,
+		inverse:      inst.CtType == "inverse",
+		lotSz:        lotSz,
+		lotPrecision: precision,
+	}, nil
+}
+
+// ContractsToCoin returns the base coin amount of contracts at price px,
+// px is only used by inverse contracts
+func (c *ContractConverter) ContractsToCoin(contracts, px float64) float64 {
+	if c.inverse {
+		return contracts * c.size / px
+	}
+	return contracts * c.size
+}
+
+// ContractsToQuote returns the quote notional of contracts at price px,
+// px is only used by linear contracts
+func (c *ContractConverter) ContractsToQuote(contracts, px float64) float64 {
+	if c.inverse {
+		return contracts * c.size
+	}
+	return contracts * c.size * px
+}
+
+// CoinToContracts returns the number of contracts worth coin base coins at price px,
+// rounded down to the lot size
+func (c *ContractConverter) CoinToContracts(coin, px float64) float64 {
+	if c.inverse {
+		return c.RoundContracts(coin * px / c.size)
+	}
+	return c.RoundContracts(coin / c.size)
+}
+
+// QuoteToContracts returns the number of contracts worth a quote notional at price px,
+// rounded down to the lot size
+func (c *ContractConverter) QuoteToContracts(quote, px float64) float64 {
+	if c.inverse {
+		return c.RoundContracts(quote / c.size)
+	}
+	return c.RoundContracts(quote / px / c.size)
+}
+
+// RoundContracts rounds contracts down to a multiple of the lot size
+func (c *ContractConverter) RoundContracts(contracts float64) float64 {
+	lots := math.Floor(contracts/c.lotSz + lotEpsilon)
+	scale := math.Pow10(c.lotPrecision)
+	return math.Round(lots*c.lotSz*scale) / scale
+}
@@ -0,0 +1,82 @@
+package okex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContractConverter(t *testing.T) {
+	assert := assert.New(t)
+	linear := &InstrumentDetail{InstType: "SWAP", InstId: "BTC-USDT-SWAP", CtVal: "0.01", CtMult: "1", CtValCcy: "BTC", CtType: "linear", LotSz: "0.1"}
+	inverse := &InstrumentDetail{InstType: "SWAP", InstId: "BTC-USD-SWAP", CtVal: "100", CtMult: "1", CtValCcy: "USD", CtType: "inverse", LotSz: "1"}
+	tests := []struct {
+		name      string
+		inst      *InstrumentDetail
+		px        float64
+		contracts float64
+		coin      float64
+		quote     float64
+		// rounded is the lot sized number of contracts converted back from coin and quote
+		rounded float64
+	}{
+		{
+			name:      "linear",
+			inst:      linear,
+			px:        20000,
+			contracts: 3,
+			coin:      0.03,
+			quote:     600,
+			rounded:   3,
+		},
+		{
+			name:      "linear rounds down to the lot size",
+			inst:      linear,
+			px:        20000,
+			contracts: 0.35,
+			coin:      0.0035,
+			quote:     70,
+			rounded:   0.3,
+		},
+		{
+			name:      "inverse",
+			inst:      inverse,
+			px:        25000,
+			contracts: 5,
+			coin:      0.02,
+			quote:     500,
+			rounded:   5,
+		},
+		{
+			name:      "inverse rounds down to the lot size",
+			inst:      inverse,
+			px:        25000,
+			contracts: 2.5,
+			coin:      0.01,
+			quote:     250,
+			rounded:   2,
+		},
+	}
+	for _, tt := range tests {
+		c, err := NewContractConverter(tt.inst)
+		assert.NoError(err, tt.name)
+		assert.InDelta(tt.coin, c.ContractsToCoin(tt.contracts, tt.px), 1e-12, tt.name)
+		assert.InDelta(tt.quote, c.ContractsToQuote(tt.contracts, tt.px), 1e-9, tt.name)
+		assert.Equal(tt.rounded, c.CoinToContracts(tt.coin, tt.px), tt.name)
+		assert.Equal(tt.rounded, c.QuoteToContracts(tt.quote, tt.px), tt.name)
+	}
+
+	_, err := NewContractConverter(&InstrumentDetail{InstType: "SPOT"})
+	assert.Error(err)
+
+	// swaps and futures without a known contract type cannot be sized
+	for _, ctType := range []string{"", "quanto"} {
+		inst := *linear
+		inst.CtType = ctType
+		_, err = NewContractConverter(&inst)
+		assert.EqualError(err, `unsupported contract type "`+ctType+`" of BTC-USDT-SWAP`)
+	}
+
+	_, err = NewContractConverter(&InstrumentDetail{InstType: "OPTION", InstId: "BTC-USD-220930-20000-C", CtVal: "0.01", CtMult: "1", LotSz: "1"})
+	assert.NoError(err)
+}
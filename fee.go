@@ -0,0 +1,103 @@
+package okex
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// FeeEstimate is the expected fee of a planned order
+type FeeEstimate struct {
+	// Fee is the amount paid in FeeCcy, a negative value is a rebate
+	Fee    float64
+	FeeCcy string
+	// Rate is the applied fee rate as returned by the exchange, negative for charges
+	Rate  float64
+	Maker bool
+}
+
+// EstimateFee returns the expected fee of an order of size sz at price px on inst, using the rates of fee.
+// Size is in base currency for SPOT/MARGIN and in contracts for SWAP, FUTURES and OPTION.
+// Limit and post only orders are assumed to rest on the book and pay the maker rate, all the
+// other order types pay the taker rate. Option fees are estimated on the underlying amount and
+// are not capped at the premium.
+func EstimateFee(fee *TradeFee, inst *InstrumentDetail, side SideType, ordType OrderType, sz, px float64) (*FeeEstimate, error) {
+	if px <= 0 {
+		return nil, fmt.Errorf("invalid price %v", px)
+	}
+
+	maker := ordType == OrderTypeLimit || ordType == OrderTypePostOnly
+	rateStr := fee.Taker
+	if maker {
+		rateStr = fee.Maker
+	}
+	if inst.InstType == "SWAP" || inst.InstType == "FUTURES" {
+		switch inst.SettleCcy {
+		case "USDT":
+			rateStr = pickRate(maker, fee.MakerU, fee.TakerU, rateStr)
+		case "USDC":
+			rateStr = pickRate(maker, fee.MakerUSDC, fee.TakerUSDC, rateStr)
+		}
+	}
+	rate, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fee rate %q: %w", rateStr, err)
+	}
+
+	res := &FeeEstimate{Rate: rate, Maker: maker}
+	switch inst.InstType {
+	case "SPOT", "MARGIN":
+		// buys pay the fee in the received base currency, sells in the received quote currency
+		if side == SideTypeBuy {
+			res.Fee = -rate * sz
+			res.FeeCcy = inst.BaseCcy
+		} else {
+			res.Fee = -rate * sz * px
+			res.FeeCcy = inst.QuoteCcy
+		}
+	case "SWAP", "FUTURES", "OPTION":
+		ctVal, ctMult, err := contractValue(inst)
+		if err != nil {
+			return nil, err
+		}
+		amount := sz * ctVal * ctMult
+		switch {
+		case inst.InstType == "OPTION":
+			res.Fee = -rate * amount
+		case inst.CtType == "inverse":
+			res.Fee = -rate * amount / px
+		default:
+			res.Fee = -rate * amount * px
+		}
+		res.FeeCcy = inst.SettleCcy
+	default:
+		return nil, fmt.Errorf("unsupported instrument type %q", inst.InstType)
+	}
+	return res, nil
+}
+
+func pickRate(maker bool, makerRate, takerRate, fallback string) string {
+	rate := takerRate
+	if maker {
+		rate = makerRate
+	}
+	if rate == "" {
+		return fallback
+	}
+	return rate
+}
+
+// contractValue parses the contract value and multiplier of inst, the multiplier defaults to 1
+func contractValue(inst *InstrumentDetail) (ctVal, ctMult float64, err error) {
+	ctVal, err = strconv.ParseFloat(inst.CtVal, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid contract value %q: %w", inst.CtVal, err)
+	}
+	ctMult = 1
+	if inst.CtMult != "" {
+		ctMult, err = strconv.ParseFloat(inst.CtMult, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("invalid contract multiplier %q: %w", inst.CtMult, err)
+		}
+	}
+	return ctVal, ctMult, nil
+}
@@ -0,0 +1,87 @@
+package okex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateFee(t *testing.T) {
+	assert := assert.New(t)
+	fee := &TradeFee{
+		Maker:  "-0.0008",
+		Taker:  "-0.001",
+		MakerU: "-0.0002",
+		TakerU: "-0.0005",
+	}
+	type args struct {
+		inst    *InstrumentDetail
+		side    SideType
+		ordType OrderType
+		sz      float64
+		px      float64
+	}
+	tests := []struct {
+		name   string
+		args   args
+		fee    float64
+		feeCcy string
+	}{
+		{
+			name: "spot buy pays taker fee in base currency",
+			args: args{
+				inst:    &InstrumentDetail{InstType: "SPOT", BaseCcy: "BTC", QuoteCcy: "USDT"},
+				side:    SideTypeBuy,
+				ordType: OrderTypeMarket,
+				sz:      2,
+				px:      20000,
+			},
+			fee:    0.002,
+			feeCcy: "BTC",
+		},
+		{
+			name: "spot sell pays maker fee in quote currency",
+			args: args{
+				inst:    &InstrumentDetail{InstType: "SPOT", BaseCcy: "BTC", QuoteCcy: "USDT"},
+				side:    SideTypeSell,
+				ordType: OrderTypePostOnly,
+				sz:      2,
+				px:      20000,
+			},
+			fee:    32,
+			feeCcy: "USDT",
+		},
+		{
+			name: "linear swap uses USDT margined rates",
+			args: args{
+				inst:    &InstrumentDetail{InstType: "SWAP", CtType: "linear", CtVal: "0.01", CtMult: "1", SettleCcy: "USDT"},
+				side:    SideTypeBuy,
+				ordType: OrderTypeMarket,
+				sz:      100,
+				px:      20000,
+			},
+			fee:    10,
+			feeCcy: "USDT",
+		},
+		{
+			name: "inverse swap pays fee in coin",
+			args: args{
+				inst:    &InstrumentDetail{InstType: "SWAP", CtType: "inverse", CtVal: "100", CtMult: "1", SettleCcy: "BTC"},
+				side:    SideTypeSell,
+				ordType: OrderTypeLimit,
+				sz:      10,
+				px:      20000,
+			},
+			fee:    0.00004,
+			feeCcy: "BTC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := EstimateFee(fee, tt.args.inst, tt.args.side, tt.args.ordType, tt.args.sz, tt.args.px)
+			assert.NoError(err)
+			assert.InDelta(tt.fee, res.Fee, 1e-9)
+			assert.Equal(tt.feeCcy, res.FeeCcy)
+		})
+	}
+}
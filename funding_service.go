@@ -2,8 +2,40 @@ package okex
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
+
+	. "github.com/tbtc-bot/go-okex/common"
+)
+
+// AccountType define the account a transfer moves funds from or to
+type AccountType string
+
+// TransferStateType define the state of a transfer
+type TransferStateType string
+
+// TransferType define between which accounts of the master and sub-accounts a transfer is made
+type TransferType string
+
+const (
+	AccountTypeFunding AccountType = "6"
+	AccountTypeTrading AccountType = "18"
+
+	TransferStateSuccess TransferStateType = "success"
+	TransferStatePending TransferStateType = "pending"
+	TransferStateFailed  TransferStateType = "failed"
+
+	TransferTypeWithinAccount       TransferType = "0"
+	TransferTypeMasterToSub         TransferType = "1"
+	TransferTypeSubToMasterByMaster TransferType = "2" // with the master account API key
+	TransferTypeSubToMasterBySub    TransferType = "3" // with the sub-account API key
+	TransferTypeSubToSub            TransferType = "4" // with the sub-account API key
 )
 
 // Fund Transfer
@@ -12,13 +44,14 @@ type FundTransferService struct {
 	c            *Client
 	ccy          string
 	amt          string
-	from         string
-	to           string
+	from         AccountType
+	to           AccountType
 	subAcct      *string
 	instId       *string
 	toInstId     *string
-	transferType *string
+	transferType *TransferType
 	loanTrans    *bool
+	clientId     *string
 }
 
 // Set Currency
@@ -34,13 +67,13 @@ func (s *FundTransferService) Amount(amt string) *FundTransferService {
 }
 
 // Set From
-func (s *FundTransferService) From(from string) *FundTransferService {
+func (s *FundTransferService) From(from AccountType) *FundTransferService {
 	s.from = from
 	return s
 }
 
 // Set To
-func (s *FundTransferService) To(to string) *FundTransferService {
+func (s *FundTransferService) To(to AccountType) *FundTransferService {
 	s.to = to
 	return s
 }
@@ -63,12 +96,24 @@ func (s *FundTransferService) ToInstrumentId(toInstId string) *FundTransferServi
 	return s
 }
 
-// Set Transfer Type
-func (s *FundTransferService) TransferType(transferType string) *FundTransferService {
+// Set Transfer Type, defaults to a transfer within the account
+func (s *FundTransferService) TransferType(transferType TransferType) *FundTransferService {
 	s.transferType = &transferType
 	return s
 }
 
+// Set Loan Transfer, whether or not borrowed coins can be transferred out
+func (s *FundTransferService) LoanTransfer(loanTrans bool) *FundTransferService {
+	s.loanTrans = &loanTrans
+	return s
+}
+
+// Set Client Id, 1-32 alphanumeric characters, a repeated client id is not transferred twice
+func (s *FundTransferService) ClientId(clientId string) *FundTransferService {
+	s.clientId = &clientId
+	return s
+}
+
 // Do send request
 func (s *FundTransferService) Do(ctx context.Context, opts ...RequestOption) (res *FundTransferServiceResponse, err error) {
 	r := &request{
@@ -79,11 +124,11 @@ func (s *FundTransferService) Do(ctx context.Context, opts ...RequestOption) (re
 
 	r.setBodyParam("ccy", s.ccy)
 	r.setBodyParam("amt", s.amt)
-	r.setBodyParam("from", s.from)
-	r.setBodyParam("to", s.to)
+	r.setBodyParam("from", string(s.from))
+	r.setBodyParam("to", string(s.to))
 
 	if s.subAcct != nil {
-		r.setBodyParam("ccy", *s.subAcct)
+		r.setBodyParam("subAcct", *s.subAcct)
 	}
 
 	if s.instId != nil {
@@ -93,7 +138,13 @@ func (s *FundTransferService) Do(ctx context.Context, opts ...RequestOption) (re
 		r.setBodyParam("toInstId", *s.toInstId)
 	}
 	if s.transferType != nil {
-		r.setBodyParam("transferType", *s.transferType)
+		r.setBodyParam("type", string(*s.transferType))
+	}
+	if s.loanTrans != nil {
+		r.setBodyParam("loanTrans", strconv.FormatBool(*s.loanTrans))
+	}
+	if s.clientId != nil {
+		r.setBodyParam("clientId", *s.clientId)
 	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
@@ -108,6 +159,99 @@ func (s *FundTransferService) Do(ctx context.Context, opts ...RequestOption) (re
 	return res, nil
 }
 
+// transientCodes are the API error codes after which TransferAndWait keeps polling:
+// service unavailable, request timeout, rate limited and system busy
+var transientCodes = map[string]bool{
+	"50001": true,
+	"50004": true,
+	"50011": true,
+	"50013": true,
+}
+
+// TransferAndWait sends the transfer and polls its state every interval until it succeeds or fails.
+// A random client id is set if none was given, so that the transfer can still be tracked when the
+// response to the transfer request is lost. Until the exchange acknowledged it, the transfer is sent
+// again with the same client id whenever its state is not found. Polling goes on after transport
+// errors, transient API errors and while the transfer is not found yet; any other API error is returned.
+func (s *FundTransferService) TransferAndWait(ctx context.Context, interval time.Duration, opts ...RequestOption) (*TransferState, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("invalid poll interval %s", interval)
+	}
+	if s.clientId == nil {
+		clientId, err := newClientId()
+		if err != nil {
+			return nil, err
+		}
+		s.ClientId(clientId)
+	}
+
+	state := s.c.NewGetTransferStateService().ClientId(*s.clientId)
+	// the state of master and sub-account transfers is only found with their transfer type
+	if s.transferType != nil {
+		state.TransferType(*s.transferType)
+	}
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	sent, found := false, false
+	for {
+		if !sent && !found {
+			res, err := s.Do(ctx, opts...)
+			switch {
+			case err != nil:
+				if apiErr, ok := err.(*APIError); ok && !transientCodes[apiErr.Code] {
+					return nil, err
+				}
+			case res.Code != "0":
+				if !transientCodes[res.Code] {
+					return nil, &APIError{Code: res.Code, Message: res.Msg}
+				}
+			default:
+				sent = true
+				if len(res.Data) > 0 {
+					state.transId, state.clientId = &res.Data[0].TransId, nil
+				}
+			}
+		}
+
+		stateRes, err := state.Do(ctx, opts...)
+		switch {
+		case err != nil:
+			if apiErr, ok := err.(*APIError); ok && !transientCodes[apiErr.Code] {
+				return nil, err
+			}
+		case stateRes.Code != "0":
+			if !transientCodes[stateRes.Code] {
+				return nil, &APIError{Code: stateRes.Code, Message: stateRes.Msg}
+			}
+		case len(stateRes.Data) > 0:
+			found = true
+			switch st := stateRes.Data[0]; st.State {
+			case TransferStateSuccess:
+				return st, nil
+			case TransferStateFailed:
+				return st, fmt.Errorf("transfer %s failed", st.TransId)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// newClientId returns a random 32 characters hexadecimal id
+func newClientId() (string, error) {
+	b := make([]byte, 16)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
 type FundTransferServiceResponse struct {
 	Code string                `json:"code"`
 	Data []*FundTransferDetail `json:"data"`
@@ -115,9 +259,930 @@ type FundTransferServiceResponse struct {
 }
 
 type FundTransferDetail struct {
-	TransId string `json:"transId"`
-	Ccy     string `json:"ccy"`
-	From    string `json:"from"`
-	Amt     string `json:"amt"`
-	To      string `json:"to"`
+	TransId  string `json:"transId"`
+	ClientId string `json:"clientId"`
+	Ccy      string `json:"ccy"`
+	From     string `json:"from"`
+	Amt      string `json:"amt"`
+	To       string `json:"to"`
+}
+
+// GetTransferStateService get the state of a transfer
+type GetTransferStateService struct {
+	c            *Client
+	transId      *string
+	clientId     *string
+	transferType *TransferType
+}
+
+// Set Transfer Id, either transfer id or client id is required
+func (s *GetTransferStateService) TransferId(transId string) *GetTransferStateService {
+	s.transId = &transId
+	return s
+}
+
+// Set Client Id, either transfer id or client id is required
+func (s *GetTransferStateService) ClientId(clientId string) *GetTransferStateService {
+	s.clientId = &clientId
+	return s
+}
+
+// Set Transfer Type, as given to FundTransferService
+func (s *GetTransferStateService) TransferType(transferType TransferType) *GetTransferStateService {
+	s.transferType = &transferType
+	return s
+}
+
+// Do send request
+func (s *GetTransferStateService) Do(ctx context.Context, opts ...RequestOption) (res *GetTransferStateServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/transfer-state",
+		secType:  secTypeSigned,
+	}
+
+	if s.transId != nil {
+		r.setParam("transId", *s.transId)
+	}
+	if s.clientId != nil {
+		r.setParam("clientId", *s.clientId)
+	}
+	if s.transferType != nil {
+		r.setParam("type", string(*s.transferType))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetTransferStateServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetTransferStateServiceResponse struct {
+	Code string           `json:"code"`
+	Data []*TransferState `json:"data"`
+	Msg  string           `json:"msg"`
+}
+
+type TransferState struct {
+	TransId  string            `json:"transId"`
+	ClientId string            `json:"clientId"`
+	Ccy      string            `json:"ccy"`
+	Amt      string            `json:"amt"`
+	Type     TransferType      `json:"type"`
+	From     AccountType       `json:"from"`
+	To       AccountType       `json:"to"`
+	SubAcct  string            `json:"subAcct"`
+	InstId   string            `json:"instId"`
+	ToInstId string            `json:"toInstId"`
+	State    TransferStateType `json:"state"`
+}
+
+// GetCurrenciesService get the currencies available to the account with their chains and withdrawal parameters
+type GetCurrenciesService struct {
+	c   *Client
+	ccy *string
+}
+
+// Set Currency, up to 20 comma separated currencies
+func (s *GetCurrenciesService) Currency(ccy string) *GetCurrenciesService {
+	s.ccy = &ccy
+	return s
+}
+
+// Do send request
+func (s *GetCurrenciesService) Do(ctx context.Context, opts ...RequestOption) (res *GetCurrenciesServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/currencies",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetCurrenciesServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetCurrenciesServiceResponse struct {
+	Code string            `json:"code"`
+	Data []*CurrencyDetail `json:"data"`
+	Msg  string            `json:"msg"`
+}
+
+// CurrencyDetail describes a currency on one of its chains
+type CurrencyDetail struct {
+	Ccy                  string `json:"ccy"`
+	Name                 string `json:"name"`
+	LogoLink             string `json:"logoLink"`
+	Chain                string `json:"chain"`
+	MainNet              bool   `json:"mainNet"`
+	CanDep               bool   `json:"canDep"`
+	CanWd                bool   `json:"canWd"`
+	CanInternal          bool   `json:"canInternal"`
+	NeedTag              bool   `json:"needTag"`
+	MinDep               string `json:"minDep"`
+	MinWd                string `json:"minWd"`
+	MaxWd                string `json:"maxWd"`
+	WdTickSz             string `json:"wdTickSz"`
+	WdQuota              string `json:"wdQuota"`
+	UsedWdQuota          string `json:"usedWdQuota"`
+	MinFee               string `json:"minFee"`
+	MaxFee               string `json:"maxFee"`
+	MinDepArrivalConfirm string `json:"minDepArrivalConfirm"`
+	MinWdUnlockConfirm   string `json:"minWdUnlockConfirm"`
+	DepQuotaFixed        string `json:"depQuotaFixed"`
+	UsedDepQuotaFixed    string `json:"usedDepQuotaFixed"`
+}
+
+// GetFundingBalanceService get the balances of the funding account
+type GetFundingBalanceService struct {
+	c   *Client
+	ccy *string
+}
+
+// Set Currency, up to 20 comma separated currencies
+func (s *GetFundingBalanceService) Currency(ccy string) *GetFundingBalanceService {
+	s.ccy = &ccy
+	return s
+}
+
+// Do send request
+func (s *GetFundingBalanceService) Do(ctx context.Context, opts ...RequestOption) (res *GetFundingBalanceServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/balances",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetFundingBalanceServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetFundingBalanceServiceResponse struct {
+	Code string            `json:"code"`
+	Data []*FundingBalance `json:"data"`
+	Msg  string            `json:"msg"`
+}
+
+type FundingBalance struct {
+	Ccy       string `json:"ccy"`
+	Bal       string `json:"bal"`
+	FrozenBal string `json:"frozenBal"`
+	AvailBal  string `json:"availBal"`
+}
+
+// GetAssetValuationService get the valuation of the total assets of the account
+type GetAssetValuationService struct {
+	c   *Client
+	ccy *string
+}
+
+// Set Currency, the valuation unit (BTC, USDT, USD...), defaults to BTC
+func (s *GetAssetValuationService) Currency(ccy string) *GetAssetValuationService {
+	s.ccy = &ccy
+	return s
+}
+
+// Do send request
+func (s *GetAssetValuationService) Do(ctx context.Context, opts ...RequestOption) (res *GetAssetValuationServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/asset-valuation",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetAssetValuationServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetAssetValuationServiceResponse struct {
+	Code string            `json:"code"`
+	Data []*AssetValuation `json:"data"`
+	Msg  string            `json:"msg"`
+}
+
+type AssetValuation struct {
+	TotalBal string                `json:"totalBal"`
+	Ts       string                `json:"ts"`
+	Details  *AssetValuationDetail `json:"details"`
+}
+
+// AssetValuationDetail splits the valuation by account
+type AssetValuationDetail struct {
+	Funding string `json:"funding"`
+	Trading string `json:"trading"`
+	Classic string `json:"classic"`
+	Earn    string `json:"earn"`
+}
+
+// GetNonTradableAssetsService get the assets of the funding account that cannot be traded
+type GetNonTradableAssetsService struct {
+	c   *Client
+	ccy *string
+}
+
+// Set Currency, up to 20 comma separated currencies
+func (s *GetNonTradableAssetsService) Currency(ccy string) *GetNonTradableAssetsService {
+	s.ccy = &ccy
+	return s
+}
+
+// Do send request
+func (s *GetNonTradableAssetsService) Do(ctx context.Context, opts ...RequestOption) (res *GetNonTradableAssetsServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/non-tradable-assets",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetNonTradableAssetsServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetNonTradableAssetsServiceResponse struct {
+	Code string              `json:"code"`
+	Data []*NonTradableAsset `json:"data"`
+	Msg  string              `json:"msg"`
+}
+
+type NonTradableAsset struct {
+	Ccy      string `json:"ccy"`
+	Name     string `json:"name"`
+	LogoLink string `json:"logoLink"`
+	Bal      string `json:"bal"`
+	CanWd    bool   `json:"canWd"`
+	Chain    string `json:"chain"`
+	MinWd    string `json:"minWd"`
+	WdAll    bool   `json:"wdAll"`
+	Fee      string `json:"fee"`
+	CtAddr   string `json:"ctAddr"`
+	WdTickSz string `json:"wdTickSz"`
+	NeedTag  bool   `json:"needTag"`
+}
+
+// ErrWithdrawalsDisabled is returned by WithdrawalService when Client.EnableWithdrawals is not set
+var ErrWithdrawalsDisabled = errors.New("withdrawals are disabled, set Client.EnableWithdrawals to allow them")
+
+// DepositStateType define the state of a deposit
+type DepositStateType string
+
+// WithdrawalStateType define the state of a withdrawal
+type WithdrawalStateType string
+
+// WithdrawalDestType define the destination of a withdrawal
+type WithdrawalDestType string
+
+const (
+	DepositStateWaitingConfirmation DepositStateType = "0"
+	DepositStateCredited            DepositStateType = "1"
+	DepositStateSuccessful          DepositStateType = "2"
+	DepositStatePending             DepositStateType = "8"
+	DepositStateBlacklisted         DepositStateType = "11"
+	DepositStateFrozen              DepositStateType = "12"
+	DepositStateIntercepted         DepositStateType = "13"
+	DepositStateKYCLimit            DepositStateType = "14"
+
+	WithdrawalStateCanceling       WithdrawalStateType = "-3"
+	WithdrawalStateCanceled        WithdrawalStateType = "-2"
+	WithdrawalStateFailed          WithdrawalStateType = "-1"
+	WithdrawalStateWaiting         WithdrawalStateType = "0"
+	WithdrawalStateWithdrawing     WithdrawalStateType = "1"
+	WithdrawalStateSuccess         WithdrawalStateType = "2"
+	WithdrawalStateApproved        WithdrawalStateType = "7"
+	WithdrawalStateWaitingTransfer WithdrawalStateType = "10"
+
+	WithdrawalDestInternal WithdrawalDestType = "3"
+	WithdrawalDestOnChain  WithdrawalDestType = "4"
+)
+
+// GetDepositAddressService get the deposit addresses of a currency
+type GetDepositAddressService struct {
+	c   *Client
+	ccy string
+}
+
+// Set Currency
+func (s *GetDepositAddressService) Currency(ccy string) *GetDepositAddressService {
+	s.ccy = ccy
+	return s
+}
+
+// Do send request
+func (s *GetDepositAddressService) Do(ctx context.Context, opts ...RequestOption) (res *GetDepositAddressServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/deposit-address",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("ccy", s.ccy)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetDepositAddressServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetDepositAddressServiceResponse struct {
+	Code string            `json:"code"`
+	Data []*DepositAddress `json:"data"`
+	Msg  string            `json:"msg"`
+}
+
+type DepositAddress struct {
+	Ccy          string            `json:"ccy"`
+	Chain        string            `json:"chain"`
+	Addr         string            `json:"addr"`
+	Tag          string            `json:"tag"`
+	Memo         string            `json:"memo"`
+	PmtId        string            `json:"pmtId"`
+	AddrEx       map[string]string `json:"addrEx"`
+	CtAddr       string            `json:"ctAddr"`
+	To           string            `json:"to"`
+	Selected     bool              `json:"selected"`
+	VerifiedName string            `json:"verifiedName"`
+}
+
+// GetDepositHistoryService get the deposit records of the last 3 months
+type GetDepositHistoryService struct {
+	c        *Client
+	ccy      *string
+	depId    *string
+	fromWdId *string
+	txId     *string
+	typ      *WithdrawalDestType
+	state    *DepositStateType
+	after    *string
+	before   *string
+	limit    *string
+}
+
+// Set Currency
+func (s *GetDepositHistoryService) Currency(ccy string) *GetDepositHistoryService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set Deposit Id
+func (s *GetDepositHistoryService) DepositId(depId string) *GetDepositHistoryService {
+	s.depId = &depId
+	return s
+}
+
+// Set From Withdrawal Id, the internal transfer initiator's withdrawal Id
+func (s *GetDepositHistoryService) FromWithdrawalId(fromWdId string) *GetDepositHistoryService {
+	s.fromWdId = &fromWdId
+	return s
+}
+
+// Set Transaction Id
+func (s *GetDepositHistoryService) TransactionId(txId string) *GetDepositHistoryService {
+	s.txId = &txId
+	return s
+}
+
+// Set Type, internal or on-chain
+func (s *GetDepositHistoryService) Type(typ WithdrawalDestType) *GetDepositHistoryService {
+	s.typ = &typ
+	return s
+}
+
+// Set State
+func (s *GetDepositHistoryService) State(state DepositStateType) *GetDepositHistoryService {
+	s.state = &state
+	return s
+}
+
+// Set after, pagination of data to return records earlier than the requested ts in ms
+func (s *GetDepositHistoryService) After(after string) *GetDepositHistoryService {
+	s.after = &after
+	return s
+}
+
+// Set before, pagination of data to return records newer than the requested ts in ms
+func (s *GetDepositHistoryService) Before(before string) *GetDepositHistoryService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 100
+func (s *GetDepositHistoryService) Limit(limit string) *GetDepositHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetDepositHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *GetDepositHistoryServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/deposit-history",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.depId != nil {
+		r.setParam("depId", *s.depId)
+	}
+	if s.fromWdId != nil {
+		r.setParam("fromWdId", *s.fromWdId)
+	}
+	if s.txId != nil {
+		r.setParam("txId", *s.txId)
+	}
+	if s.typ != nil {
+		r.setParam("type", string(*s.typ))
+	}
+	if s.state != nil {
+		r.setParam("state", string(*s.state))
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetDepositHistoryServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetDepositHistoryServiceResponse struct {
+	Code string     `json:"code"`
+	Data []*Deposit `json:"data"`
+	Msg  string     `json:"msg"`
+}
+
+type Deposit struct {
+	Ccy                 string           `json:"ccy"`
+	Chain               string           `json:"chain"`
+	Amt                 string           `json:"amt"`
+	From                string           `json:"from"`
+	AreaCodeFrom        string           `json:"areaCodeFrom"`
+	To                  string           `json:"to"`
+	TxId                string           `json:"txId"`
+	DepId               string           `json:"depId"`
+	FromWdId            string           `json:"fromWdId"`
+	State               DepositStateType `json:"state"`
+	ActualDepBlkConfirm string           `json:"actualDepBlkConfirm"`
+	Ts                  string           `json:"ts"`
+}
+
+// WithdrawalService withdraw funds on-chain or to another OKX account.
+// It is refused with ErrWithdrawalsDisabled unless Client.EnableWithdrawals is set.
+type WithdrawalService struct {
+	c        *Client
+	ccy      string
+	amt      string
+	dest     WithdrawalDestType
+	toAddr   string
+	fee      string
+	chain    *string
+	areaCode *string
+	clientId *string
+}
+
+// Set Currency
+func (s *WithdrawalService) Currency(ccy string) *WithdrawalService {
+	s.ccy = ccy
+	return s
+}
+
+// Set Amount, excluding the fee
+func (s *WithdrawalService) Amount(amt string) *WithdrawalService {
+	s.amt = amt
+	return s
+}
+
+// Set Destination, internal or on-chain
+func (s *WithdrawalService) Destination(dest WithdrawalDestType) *WithdrawalService {
+	s.dest = dest
+	return s
+}
+
+// Set To Address, a verified address for on-chain withdrawals, an email, phone or login account name for internal ones
+func (s *WithdrawalService) ToAddress(toAddr string) *WithdrawalService {
+	s.toAddr = toAddr
+	return s
+}
+
+// Set Fee, 0 for internal withdrawals, see GetCurrenciesService for on-chain fees
+func (s *WithdrawalService) Fee(fee string) *WithdrawalService {
+	s.fee = fee
+	return s
+}
+
+// Set Chain, e.g. USDT-ERC20, the main chain is used if not set
+func (s *WithdrawalService) Chain(chain string) *WithdrawalService {
+	s.chain = &chain
+	return s
+}
+
+// Set Area Code, required when withdrawing internally to a phone number
+func (s *WithdrawalService) AreaCode(areaCode string) *WithdrawalService {
+	s.areaCode = &areaCode
+	return s
+}
+
+// Set Client Id
+func (s *WithdrawalService) ClientId(clientId string) *WithdrawalService {
+	s.clientId = &clientId
+	return s
+}
+
+// Do send request
+func (s *WithdrawalService) Do(ctx context.Context, opts ...RequestOption) (res *WithdrawalServiceResponse, err error) {
+	if !s.c.EnableWithdrawals {
+		return nil, ErrWithdrawalsDisabled
+	}
+
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/asset/withdrawal",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("ccy", s.ccy)
+	r.setBodyParam("amt", s.amt)
+	r.setBodyParam("dest", string(s.dest))
+	r.setBodyParam("toAddr", s.toAddr)
+	r.setBodyParam("fee", s.fee)
+
+	if s.chain != nil {
+		r.setBodyParam("chain", *s.chain)
+	}
+	if s.areaCode != nil {
+		r.setBodyParam("areaCode", *s.areaCode)
+	}
+	if s.clientId != nil {
+		r.setBodyParam("clientId", *s.clientId)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(WithdrawalServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type WithdrawalServiceResponse struct {
+	Code string              `json:"code"`
+	Data []*WithdrawalDetail `json:"data"`
+	Msg  string              `json:"msg"`
+}
+
+type WithdrawalDetail struct {
+	Ccy      string `json:"ccy"`
+	Chain    string `json:"chain"`
+	Amt      string `json:"amt"`
+	WdId     string `json:"wdId"`
+	ClientId string `json:"clientId"`
+}
+
+// CancelWithdrawalService cancel a pending withdrawal
+type CancelWithdrawalService struct {
+	c    *Client
+	wdId string
+}
+
+// Set Withdrawal Id
+func (s *CancelWithdrawalService) WithdrawalId(wdId string) *CancelWithdrawalService {
+	s.wdId = wdId
+	return s
+}
+
+// Do send request
+func (s *CancelWithdrawalService) Do(ctx context.Context, opts ...RequestOption) (res *CancelWithdrawalServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/asset/cancel-withdrawal",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("wdId", s.wdId)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CancelWithdrawalServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type CancelWithdrawalServiceResponse struct {
+	Code string              `json:"code"`
+	Data []*WithdrawalDetail `json:"data"`
+	Msg  string              `json:"msg"`
+}
+
+// GetWithdrawalHistoryService get the withdrawal records of the last 3 months
+type GetWithdrawalHistoryService struct {
+	c        *Client
+	ccy      *string
+	wdId     *string
+	clientId *string
+	txId     *string
+	typ      *WithdrawalDestType
+	state    *WithdrawalStateType
+	after    *string
+	before   *string
+	limit    *string
+}
+
+// Set Currency
+func (s *GetWithdrawalHistoryService) Currency(ccy string) *GetWithdrawalHistoryService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set Withdrawal Id
+func (s *GetWithdrawalHistoryService) WithdrawalId(wdId string) *GetWithdrawalHistoryService {
+	s.wdId = &wdId
+	return s
+}
+
+// Set Client Id
+func (s *GetWithdrawalHistoryService) ClientId(clientId string) *GetWithdrawalHistoryService {
+	s.clientId = &clientId
+	return s
+}
+
+// Set Transaction Id
+func (s *GetWithdrawalHistoryService) TransactionId(txId string) *GetWithdrawalHistoryService {
+	s.txId = &txId
+	return s
+}
+
+// Set Type, internal or on-chain
+func (s *GetWithdrawalHistoryService) Type(typ WithdrawalDestType) *GetWithdrawalHistoryService {
+	s.typ = &typ
+	return s
+}
+
+// Set State
+func (s *GetWithdrawalHistoryService) State(state WithdrawalStateType) *GetWithdrawalHistoryService {
+	s.state = &state
+	return s
+}
+
+// Set after, pagination of data to return records earlier than the requested ts in ms
+func (s *GetWithdrawalHistoryService) After(after string) *GetWithdrawalHistoryService {
+	s.after = &after
+	return s
+}
+
+// Set before, pagination of data to return records newer than the requested ts in ms
+func (s *GetWithdrawalHistoryService) Before(before string) *GetWithdrawalHistoryService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 100
+func (s *GetWithdrawalHistoryService) Limit(limit string) *GetWithdrawalHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetWithdrawalHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *GetWithdrawalHistoryServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/withdrawal-history",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.wdId != nil {
+		r.setParam("wdId", *s.wdId)
+	}
+	if s.clientId != nil {
+		r.setParam("clientId", *s.clientId)
+	}
+	if s.txId != nil {
+		r.setParam("txId", *s.txId)
+	}
+	if s.typ != nil {
+		r.setParam("type", string(*s.typ))
+	}
+	if s.state != nil {
+		r.setParam("state", string(*s.state))
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetWithdrawalHistoryServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetWithdrawalHistoryServiceResponse struct {
+	Code string        `json:"code"`
+	Data []*Withdrawal `json:"data"`
+	Msg  string        `json:"msg"`
+}
+
+type Withdrawal struct {
+	Ccy              string              `json:"ccy"`
+	Chain            string              `json:"chain"`
+	NonTradableAsset bool                `json:"nonTradableAsset"`
+	Amt              string              `json:"amt"`
+	From             string              `json:"from"`
+	AreaCodeFrom     string              `json:"areaCodeFrom"`
+	To               string              `json:"to"`
+	AreaCodeTo       string              `json:"areaCodeTo"`
+	Tag              string              `json:"tag"`
+	PmtId            string              `json:"pmtId"`
+	Memo             string              `json:"memo"`
+	AddrEx           map[string]string   `json:"addrEx"`
+	TxId             string              `json:"txId"`
+	Fee              string              `json:"fee"`
+	FeeCcy           string              `json:"feeCcy"`
+	State            WithdrawalStateType `json:"state"`
+	WdId             string              `json:"wdId"`
+	ClientId         string              `json:"clientId"`
+	Ts               string              `json:"ts"`
+}
+
+// GetDepositWithdrawStatusService get the detailed status and estimated completion time of a deposit or withdrawal
+type GetDepositWithdrawStatusService struct {
+	c     *Client
+	wdId  *string
+	txId  *string
+	ccy   *string
+	to    *string
+	chain *string
+}
+
+// Set Withdrawal Id, required to query a withdrawal
+func (s *GetDepositWithdrawStatusService) WithdrawalId(wdId string) *GetDepositWithdrawStatusService {
+	s.wdId = &wdId
+	return s
+}
+
+// Set Transaction Id, required together with Currency, To and Chain to query a deposit
+func (s *GetDepositWithdrawStatusService) TransactionId(txId string) *GetDepositWithdrawStatusService {
+	s.txId = &txId
+	return s
+}
+
+// Set Currency
+func (s *GetDepositWithdrawStatusService) Currency(ccy string) *GetDepositWithdrawStatusService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set To, the receiving address
+func (s *GetDepositWithdrawStatusService) To(to string) *GetDepositWithdrawStatusService {
+	s.to = &to
+	return s
+}
+
+// Set Chain
+func (s *GetDepositWithdrawStatusService) Chain(chain string) *GetDepositWithdrawStatusService {
+	s.chain = &chain
+	return s
+}
+
+// Do send request
+func (s *GetDepositWithdrawStatusService) Do(ctx context.Context, opts ...RequestOption) (res *GetDepositWithdrawStatusServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/deposit-withdraw-status",
+		secType:  secTypeSigned,
+	}
+
+	if s.wdId != nil {
+		r.setParam("wdId", *s.wdId)
+	}
+	if s.txId != nil {
+		r.setParam("txId", *s.txId)
+	}
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.to != nil {
+		r.setParam("to", *s.to)
+	}
+	if s.chain != nil {
+		r.setParam("chain", *s.chain)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetDepositWithdrawStatusServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetDepositWithdrawStatusServiceResponse struct {
+	Code string                   `json:"code"`
+	Data []*DepositWithdrawStatus `json:"data"`
+	Msg  string                   `json:"msg"`
+}
+
+type DepositWithdrawStatus struct {
+	WdId            string `json:"wdId"`
+	TxId            string `json:"txId"`
+	State           string `json:"state"`
+	EstCompleteTime string `json:"estCompleteTime"`
 }
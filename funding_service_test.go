@@ -0,0 +1,177 @@
+package okex
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/tbtc-bot/go-okex/common"
+)
+
+func TestTransferAndWait(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name         string
+		transferType TransferType
+		// states are the successive answers to the transfer state queries
+		states  []map[string]interface{}
+		state   TransferStateType
+		wantErr string
+	}{
+		{
+			name: "success after pending",
+			states: []map[string]interface{}{
+				{"code": "0", "msg": "", "data": []interface{}{}},
+				{"code": "50011", "msg": "Too Many Requests", "data": []interface{}{}},
+				{"code": "0", "msg": "", "data": []map[string]string{{"transId": "754147", "state": "pending"}}},
+				{"code": "0", "msg": "", "data": []map[string]string{{"transId": "754147", "state": "success"}}},
+			},
+			state: TransferStateSuccess,
+		},
+		{
+			name:         "master to sub-account transfer is tracked with its type",
+			transferType: TransferTypeMasterToSub,
+			states: []map[string]interface{}{
+				{"code": "0", "msg": "", "data": []map[string]string{{"transId": "754147", "state": "success"}}},
+			},
+			state: TransferStateSuccess,
+		},
+		{
+			name: "failed transfer",
+			states: []map[string]interface{}{
+				{"code": "0", "msg": "", "data": []map[string]string{{"transId": "754147", "state": "failed"}}},
+			},
+			state:   TransferStateFailed,
+			wantErr: "transfer 754147 failed",
+		},
+		{
+			name: "permanent API error",
+			states: []map[string]interface{}{
+				{"code": "51000", "msg": "Parameter transId error", "data": []interface{}{}},
+			},
+			wantErr: "<APIError> code=51000, msg=Parameter transId error",
+		},
+	}
+	for _, tt := range tests {
+		polls := 0
+		c := NewClient("", "", "")
+		c.do = func(req *http.Request) (*http.Response, error) {
+			var body interface{}
+			switch req.URL.Path {
+			case "/api/v5/asset/transfer":
+				body = map[string]interface{}{"code": "0", "msg": "", "data": []map[string]string{{"transId": "754147"}}}
+			case "/api/v5/asset/transfer-state":
+				assert.Equal("754147", req.URL.Query().Get("transId"), tt.name)
+				assert.Equal(string(tt.transferType), req.URL.Query().Get("type"), tt.name)
+				body = tt.states[polls]
+				polls++
+			default:
+				t.Fatalf("unexpected request %s", req.URL)
+			}
+			data, _ := json.Marshal(body)
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(data))}, nil
+		}
+
+		s := c.NewFundTransferService().Currency("USDT").Amount("1.5").From(AccountTypeFunding).To(AccountTypeTrading)
+		if tt.transferType != "" {
+			s.TransferType(tt.transferType).SubAccount("sub1")
+		}
+		st, err := s.TransferAndWait(context.Background(), time.Millisecond)
+		if tt.wantErr != "" {
+			assert.EqualError(err, tt.wantErr, tt.name)
+		} else {
+			assert.NoError(err, tt.name)
+		}
+		if tt.state != "" {
+			assert.Equal(tt.state, st.State, tt.name)
+		}
+		if tt.wantErr != "" && tt.state == "" {
+			assert.True(IsAPIError(err), tt.name)
+		}
+		assert.Equal(len(tt.states), polls, tt.name)
+	}
+
+	_, err := NewClient("", "", "").NewFundTransferService().TransferAndWait(context.Background(), 0)
+	assert.Error(err)
+}
+
+func TestTransferAndWaitResend(t *testing.T) {
+	assert := assert.New(t)
+	var clientIds []string
+	stateQueries := 0
+	c := NewClient("", "", "")
+	c.do = func(req *http.Request) (*http.Response, error) {
+		var body interface{}
+		switch req.URL.Path {
+		case "/api/v5/asset/transfer":
+			var params map[string]string
+			data, _ := ioutil.ReadAll(req.Body)
+			assert.NoError(json.Unmarshal(data, &params))
+			clientIds = append(clientIds, params["clientId"])
+			// the first two transfer requests never reach the exchange
+			if len(clientIds) < 3 {
+				return nil, errors.New("connection reset by peer")
+			}
+			body = map[string]interface{}{"code": "0", "msg": "", "data": []map[string]string{{"transId": "754147"}}}
+		case "/api/v5/asset/transfer-state":
+			stateQueries++
+			if req.URL.Query().Get("transId") == "" {
+				// tracked by client id, the transfer is not found
+				assert.Equal(clientIds[0], req.URL.Query().Get("clientId"))
+				body = map[string]interface{}{"code": "0", "msg": "", "data": []interface{}{}}
+			} else {
+				body = map[string]interface{}{"code": "0", "msg": "", "data": []map[string]string{{"transId": "754147", "state": "success"}}}
+			}
+		default:
+			t.Fatalf("unexpected request %s", req.URL)
+		}
+		data, _ := json.Marshal(body)
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(data))}, nil
+	}
+
+	st, err := c.NewFundTransferService().Currency("USDT").Amount("1.5").From(AccountTypeFunding).To(AccountTypeTrading).
+		TransferAndWait(context.Background(), time.Millisecond)
+	assert.NoError(err)
+	assert.Equal(TransferStateSuccess, st.State)
+
+	// the transfer is sent again with the same client id while it is not found
+	if assert.Len(clientIds, 3) {
+		assert.NotEmpty(clientIds[0])
+		assert.Equal(clientIds[0], clientIds[1])
+		assert.Equal(clientIds[0], clientIds[2])
+	}
+	assert.Equal(3, stateQueries)
+}
+
+func TestWithdrawalOptIn(t *testing.T) {
+	assert := assert.New(t)
+	sent := 0
+	c := NewClient("", "", "")
+	c.do = func(req *http.Request) (*http.Response, error) {
+		assert.Equal("/api/v5/asset/withdrawal", req.URL.Path)
+		sent++
+		data, _ := json.Marshal(map[string]interface{}{"code": "0", "msg": "", "data": []map[string]string{{"wdId": "67485"}}})
+		return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader(data))}, nil
+	}
+	withdraw := func() (*WithdrawalServiceResponse, error) {
+		return c.NewWithdrawalService().Currency("USDT").Amount("10").Destination(WithdrawalDestOnChain).
+			ToAddress("0x0000000000000000000000000000000000000000").Fee("1").Chain("USDT-ERC20").Do(context.Background())
+	}
+
+	// withdrawals are disabled by default and nothing is sent
+	_, err := withdraw()
+	assert.Equal(ErrWithdrawalsDisabled, err)
+	assert.Equal(0, sent)
+
+	c.EnableWithdrawals = true
+	res, err := withdraw()
+	assert.NoError(err)
+	assert.Equal("0", res.Code)
+	assert.Equal(1, sent)
+}
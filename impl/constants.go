@@ -171,6 +171,7 @@ const (
 	PERIOD_1DAY Period = "1D"
 
 	PERIOD_12HOUR Period = "12H"
+	PERIOD_8HOUR  Period = "8H"
 	PERIOD_6HOUR  Period = "6H"
 	PERIOD_4HOUR  Period = "4H"
 	PERIOD_2HOUR  Period = "2H"
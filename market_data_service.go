@@ -3,14 +3,19 @@ package okex
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+
+	. "github.com/tbtc-bot/go-okex/common"
+	. "github.com/tbtc-bot/go-okex/impl"
 )
 
 // GetTickersService
 type GetTickersService struct {
-	c        *Client
-	instType string
-	uly      *string
+	c          *Client
+	instType   string
+	uly        *string
+	instFamily *string
 }
 
 // Set instrument type
@@ -25,6 +30,12 @@ func (s *GetTickersService) Underlying(uly string) *GetTickersService {
 	return s
 }
 
+// Set instrument family, e.g. BTC-USD, replaces the underlying
+func (s *GetTickersService) InstrumentFamily(instFamily string) *GetTickersService {
+	s.instFamily = &instFamily
+	return s
+}
+
 // Do send request
 func (s *GetTickersService) Do(ctx context.Context, opts ...RequestOption) (res *GetTickerServiceResponse, err error) {
 	r := &request{
@@ -37,6 +48,9 @@ func (s *GetTickersService) Do(ctx context.Context, opts ...RequestOption) (res
 	if s.uly != nil {
 		r.setParam("uly", *s.uly)
 	}
+	if s.instFamily != nil {
+		r.setParam("instFamily", *s.instFamily)
+	}
 
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
@@ -108,3 +122,686 @@ type TickerDetail struct {
 	SodUtc8   string `json:"sodUtc8"`
 	Ts        string `json:"ts"`
 }
+
+// GetOrderBookService
+type GetOrderBookService struct {
+	c      *Client
+	instId string
+	sz     *string
+}
+
+// Set instrument id
+func (s *GetOrderBookService) InstrumentId(instId string) *GetOrderBookService {
+	s.instId = instId
+	return s
+}
+
+// Set order book depth per side, max 400, defaults to 1
+func (s *GetOrderBookService) Size(sz string) *GetOrderBookService {
+	s.sz = &sz
+	return s
+}
+
+// Do send request
+func (s *GetOrderBookService) Do(ctx context.Context, opts ...RequestOption) (res *GetOrderBookServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/books",
+	}
+
+	r.setParam("instId", s.instId)
+
+	if s.sz != nil {
+		r.setParam("sz", *s.sz)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetOrderBookServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetOrderBookLiteService get up to 25 orders per side of a 400 depth order book
+type GetOrderBookLiteService struct {
+	c      *Client
+	instId string
+}
+
+// Set instrument id
+func (s *GetOrderBookLiteService) InstrumentId(instId string) *GetOrderBookLiteService {
+	s.instId = instId
+	return s
+}
+
+// Do send request
+func (s *GetOrderBookLiteService) Do(ctx context.Context, opts ...RequestOption) (res *GetOrderBookServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/books-lite",
+	}
+
+	r.setParam("instId", s.instId)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetOrderBookServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetOrderBookService and GetOrderBookLiteService
+type GetOrderBookServiceResponse struct {
+	Code string       `json:"code"`
+	Msg  string       `json:"msg"`
+	Data []*OrderBook `json:"data"`
+}
+
+type OrderBook struct {
+	Asks []*OrderBookLevel `json:"asks"`
+	Bids []*OrderBookLevel `json:"bids"`
+	Ts   string            `json:"ts"`
+}
+
+// OrderBookLevel is a depth level, decoded from a [px, sz, liquidated orders, number of orders] row
+type OrderBookLevel struct {
+	PriceLevel
+	NumOrders string
+}
+
+// UnmarshalJSON decodes an order book row
+func (l *OrderBookLevel) UnmarshalJSON(data []byte) error {
+	var row []string
+	err := json.Unmarshal(data, &row)
+	if err != nil {
+		return err
+	}
+	if len(row) < 2 {
+		return fmt.Errorf("invalid order book level %s", data)
+	}
+	l.Price, l.Quantity = row[0], row[1]
+	if len(row) > 3 {
+		l.NumOrders = row[3]
+	}
+	return nil
+}
+
+// GetCandlesService get the candlesticks of an instrument, index or mark price.
+// Recent and history bars are served by different endpoints, see the NewGetXXXCandlesService constructors.
+type GetCandlesService struct {
+	c        *Client
+	endpoint string
+	instId   string
+	bar      *Period
+	after    *string
+	before   *string
+	limit    *string
+}
+
+// Set instrument id, the index (e.g. BTC-USD) for index candles
+func (s *GetCandlesService) InstrumentId(instId string) *GetCandlesService {
+	s.instId = instId
+	return s
+}
+
+// Set bar size, defaults to 1m
+func (s *GetCandlesService) Bar(bar Period) *GetCandlesService {
+	s.bar = &bar
+	return s
+}
+
+// Set after, pagination of data to return records earlier than the requested ts in ms
+func (s *GetCandlesService) After(after string) *GetCandlesService {
+	s.after = &after
+	return s
+}
+
+// Set before, pagination of data to return records newer than the requested ts in ms
+func (s *GetCandlesService) Before(before string) *GetCandlesService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 300 for recent and 100 for history candles
+func (s *GetCandlesService) Limit(limit string) *GetCandlesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetCandlesService) Do(ctx context.Context, opts ...RequestOption) (res *GetCandlesServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: s.endpoint,
+	}
+
+	r.setParam("instId", s.instId)
+
+	if s.bar != nil {
+		r.setParam("bar", string(*s.bar))
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetCandlesServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetCandlesService, newest candle first
+type GetCandlesServiceResponse struct {
+	Code string    `json:"code"`
+	Msg  string    `json:"msg"`
+	Data []*Candle `json:"data"`
+}
+
+// Candle is a candlestick, index and mark price candles have no volume
+type Candle struct {
+	Ts          string `json:"ts"`
+	Open        string `json:"o"`
+	High        string `json:"h"`
+	Low         string `json:"l"`
+	Close       string `json:"c"`
+	Vol         string `json:"vol"`
+	VolCcy      string `json:"volCcy"`
+	VolCcyQuote string `json:"volCcyQuote"`
+	// Confirm is "1" when the candle is completed and "0" while it is still updating
+	Confirm string `json:"confirm"`
+}
+
+// UnmarshalJSON decodes a [ts, o, h, l, c, vol, volCcy, volCcyQuote, confirm] or a [ts, o, h, l, c, confirm] row,
+// or the object written by the JSON lines format of CandleDownloader
+func (c *Candle) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '{' {
+		// the alias has no UnmarshalJSON method and decodes the tagged fields
+		type candle Candle
+		return json.Unmarshal(data, (*candle)(c))
+	}
+	var row []string
+	err := json.Unmarshal(data, &row)
+	if err != nil {
+		return err
+	}
+	if len(row) < 5 {
+		return fmt.Errorf("invalid candle %s", data)
+	}
+	c.Ts, c.Open, c.High, c.Low, c.Close = row[0], row[1], row[2], row[3], row[4]
+	switch len(row) {
+	case 5:
+	case 6:
+		c.Confirm = row[5]
+	default:
+		c.Vol, c.VolCcy = row[5], row[6]
+		if len(row) > 7 {
+			c.VolCcyQuote = row[7]
+		}
+		if len(row) > 8 {
+			c.Confirm = row[8]
+		}
+	}
+	return nil
+}
+
+// GetTradesService get the recent trades of an instrument
+type GetTradesService struct {
+	c      *Client
+	instId string
+	limit  *string
+}
+
+// Set instrument id
+func (s *GetTradesService) InstrumentId(instId string) *GetTradesService {
+	s.instId = instId
+	return s
+}
+
+// Set limit, max 500
+func (s *GetTradesService) Limit(limit string) *GetTradesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetTradesService) Do(ctx context.Context, opts ...RequestOption) (res *GetTradesServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/trades",
+	}
+
+	r.setParam("instId", s.instId)
+
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetTradesServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// TradesPaginationType define how GetHistoryTradesService paginates
+type TradesPaginationType string
+
+const (
+	TradesPaginationTradeId   TradesPaginationType = "1"
+	TradesPaginationTimestamp TradesPaginationType = "2"
+)
+
+// GetHistoryTradesService get the trades of an instrument of the last 3 months
+type GetHistoryTradesService struct {
+	c      *Client
+	instId string
+	typ    *TradesPaginationType
+	after  *string
+	before *string
+	limit  *string
+}
+
+// Set instrument id
+func (s *GetHistoryTradesService) InstrumentId(instId string) *GetHistoryTradesService {
+	s.instId = instId
+	return s
+}
+
+// Set pagination type, by trade id (default) or by timestamp
+func (s *GetHistoryTradesService) PaginationType(typ TradesPaginationType) *GetHistoryTradesService {
+	s.typ = &typ
+	return s
+}
+
+// Set after, pagination of data to return records earlier than the requested tradeId or ts
+func (s *GetHistoryTradesService) After(after string) *GetHistoryTradesService {
+	s.after = &after
+	return s
+}
+
+// Set before, pagination of data to return records newer than the requested tradeId
+func (s *GetHistoryTradesService) Before(before string) *GetHistoryTradesService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 100
+func (s *GetHistoryTradesService) Limit(limit string) *GetHistoryTradesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetHistoryTradesService) Do(ctx context.Context, opts ...RequestOption) (res *GetTradesServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/history-trades",
+	}
+
+	r.setParam("instId", s.instId)
+
+	if s.typ != nil {
+		r.setParam("type", string(*s.typ))
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetTradesServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetTradesService and GetHistoryTradesService, newest trade first
+type GetTradesServiceResponse struct {
+	Code string   `json:"code"`
+	Msg  string   `json:"msg"`
+	Data []*Trade `json:"data"`
+}
+
+type Trade struct {
+	InstId  string   `json:"instId"`
+	TradeId string   `json:"tradeId"`
+	Px      string   `json:"px"`
+	Sz      string   `json:"sz"`
+	Side    SideType `json:"side"`
+	Ts      string   `json:"ts"`
+}
+
+// GetOptionFamilyTradesService get the recent trades of an option instrument family
+type GetOptionFamilyTradesService struct {
+	c          *Client
+	instFamily string
+}
+
+// Set instrument family, e.g. BTC-USD
+func (s *GetOptionFamilyTradesService) InstrumentFamily(instFamily string) *GetOptionFamilyTradesService {
+	s.instFamily = instFamily
+	return s
+}
+
+// Do send request
+func (s *GetOptionFamilyTradesService) Do(ctx context.Context, opts ...RequestOption) (res *GetOptionFamilyTradesServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/option/instrument-family-trades",
+	}
+
+	r.setParam("instFamily", s.instFamily)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetOptionFamilyTradesServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetOptionFamilyTradesService
+type GetOptionFamilyTradesServiceResponse struct {
+	Code string                `json:"code"`
+	Msg  string                `json:"msg"`
+	Data []*OptionFamilyTrades `json:"data"`
+}
+
+// OptionFamilyTrades holds the trades of the calls or of the puts of an instrument family
+type OptionFamilyTrades struct {
+	OptType   string   `json:"optType"`
+	Vol24h    string   `json:"vol24h"`
+	TradeInfo []*Trade `json:"tradeInfo"`
+}
+
+// GetIndexTickersService
+type GetIndexTickersService struct {
+	c        *Client
+	quoteCcy *string
+	instId   *string
+}
+
+// Set quote currency, USD, USDT, BTC or USDC, either quote currency or index is required
+func (s *GetIndexTickersService) QuoteCurrency(quoteCcy string) *GetIndexTickersService {
+	s.quoteCcy = &quoteCcy
+	return s
+}
+
+// Set index, e.g. BTC-USD
+func (s *GetIndexTickersService) InstrumentId(instId string) *GetIndexTickersService {
+	s.instId = &instId
+	return s
+}
+
+// Do send request
+func (s *GetIndexTickersService) Do(ctx context.Context, opts ...RequestOption) (res *GetIndexTickersServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/index-tickers",
+	}
+
+	if s.quoteCcy != nil {
+		r.setParam("quoteCcy", *s.quoteCcy)
+	}
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetIndexTickersServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetIndexTickersService
+type GetIndexTickersServiceResponse struct {
+	Code string         `json:"code"`
+	Msg  string         `json:"msg"`
+	Data []*IndexTicker `json:"data"`
+}
+
+type IndexTicker struct {
+	InstId  string `json:"instId"`
+	IdxPx   string `json:"idxPx"`
+	Open24h string `json:"open24h"`
+	High24h string `json:"high24h"`
+	Low24h  string `json:"low24h"`
+	SodUtc0 string `json:"sodUtc0"`
+	SodUtc8 string `json:"sodUtc8"`
+	Ts      string `json:"ts"`
+}
+
+// GetIndexComponentsService get the exchange prices an index is built from
+type GetIndexComponentsService struct {
+	c     *Client
+	index string
+}
+
+// Set index, e.g. BTC-USDT
+func (s *GetIndexComponentsService) Index(index string) *GetIndexComponentsService {
+	s.index = index
+	return s
+}
+
+// Do send request
+func (s *GetIndexComponentsService) Do(ctx context.Context, opts ...RequestOption) (res *GetIndexComponentsServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/index-components",
+	}
+
+	r.setParam("index", s.index)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetIndexComponentsServiceResponse)
+	err = unmarshalObjectResponse(data, &res.Code, &res.Msg, &res.Data)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetIndexComponentsService, data is a single object
+type GetIndexComponentsServiceResponse struct {
+	Code string           `json:"code"`
+	Msg  string           `json:"msg"`
+	Data *IndexComponents `json:"data"`
+}
+
+type IndexComponents struct {
+	Index      string            `json:"index"`
+	Last       string            `json:"last"`
+	Ts         string            `json:"ts"`
+	Components []*IndexComponent `json:"components"`
+}
+
+// IndexComponent is the contribution of an exchange symbol to the index, weighted by Wgt
+type IndexComponent struct {
+	Exch   string `json:"exch"`
+	Symbol string `json:"symbol"`
+	SymPx  string `json:"symPx"`
+	Wgt    string `json:"wgt"`
+	CnvPx  string `json:"cnvPx"`
+}
+
+// GetPlatformVolumeService get the 24 hours trading volume of the platform
+type GetPlatformVolumeService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetPlatformVolumeService) Do(ctx context.Context, opts ...RequestOption) (res *GetPlatformVolumeServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/platform-24-volume",
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetPlatformVolumeServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetPlatformVolumeService
+type GetPlatformVolumeServiceResponse struct {
+	Code string            `json:"code"`
+	Msg  string            `json:"msg"`
+	Data []*PlatformVolume `json:"data"`
+}
+
+type PlatformVolume struct {
+	VolUsd string `json:"volUsd"`
+	VolCny string `json:"volCny"`
+	Ts     string `json:"ts"`
+}
+
+// GetExchangeRateService get the average USD/CNY exchange rate of the last 2 weeks
+type GetExchangeRateService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetExchangeRateService) Do(ctx context.Context, opts ...RequestOption) (res *GetExchangeRateServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/exchange-rate",
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetExchangeRateServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetExchangeRateService
+type GetExchangeRateServiceResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data []*ExchangeRate `json:"data"`
+}
+
+type ExchangeRate struct {
+	UsdCny string `json:"usdCny"`
+}
+
+// GetOpenOracleService get the crypto prices signed with the OKX Open Oracle private key
+type GetOpenOracleService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetOpenOracleService) Do(ctx context.Context, opts ...RequestOption) (res *GetOpenOracleServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/market/open-oracle",
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetOpenOracleServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetOpenOracleService
+type GetOpenOracleServiceResponse struct {
+	Code string        `json:"code"`
+	Msg  string        `json:"msg"`
+	Data []*OpenOracle `json:"data"`
+}
+
+type OpenOracle struct {
+	Messages   []string          `json:"messages"`
+	Prices     map[string]string `json:"prices"`
+	Signatures []string          `json:"signatures"`
+	Timestamp  string            `json:"timestamp"`
+}
+
+// unmarshalObjectResponse decodes a response whose data is a single object into code, msg and v.
+// Error responses carry an empty data array instead and are returned as an APIError.
+func unmarshalObjectResponse(data []byte, code, msg *string, v interface{}) error {
+	var raw struct {
+		Code string          `json:"code"`
+		Msg  string          `json:"msg"`
+		Data json.RawMessage `json:"data"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	if raw.Code != "0" {
+		return &APIError{Code: raw.Code, Message: raw.Msg}
+	}
+	*code, *msg = raw.Code, raw.Msg
+	if len(raw.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw.Data, v)
+}
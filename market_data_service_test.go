@@ -0,0 +1,49 @@
+package okex
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	. "github.com/tbtc-bot/go-okex/common"
+)
+
+func TestGetIndexComponents(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		name    string
+		body    string
+		want    *IndexComponents
+		wantErr string
+	}{
+		{
+			name: "components",
+			body: `{"code":"0","msg":"","data":{"index":"BTC-USDT","last":"20000.1","ts":"1630425600000",
+				"components":[{"exch":"Binance","symbol":"BTC/USDT","symPx":"20000.2","wgt":"0.5","cnvPx":"20000.2"}]}}`,
+			want: &IndexComponents{Index: "BTC-USDT", Last: "20000.1", Ts: "1630425600000",
+				Components: []*IndexComponent{{Exch: "Binance", Symbol: "BTC/USDT", SymPx: "20000.2", Wgt: "0.5", CnvPx: "20000.2"}}},
+		},
+		{
+			name:    "error with an empty data array",
+			body:    `{"code":"51001","msg":"Instrument ID does not exist","data":[]}`,
+			wantErr: "<APIError> code=51001, msg=Instrument ID does not exist",
+		},
+	}
+	for _, tt := range tests {
+		c := NewClient("", "", "")
+		c.do = func(req *http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(bytes.NewReader([]byte(tt.body)))}, nil
+		}
+		res, err := c.NewGetIndexComponentsService().Index("BTC-USDT").Do(context.Background())
+		if tt.wantErr != "" {
+			assert.EqualError(err, tt.wantErr, tt.name)
+			assert.True(IsAPIError(err), tt.name)
+			continue
+		}
+		assert.NoError(err, tt.name)
+		assert.Equal(tt.want, res.Data, tt.name)
+	}
+}
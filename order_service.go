@@ -4,8 +4,13 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
+
+	. "github.com/tbtc-bot/go-okex/common"
 )
 
 // PlaceOrderService place a single order
@@ -263,15 +268,16 @@ type CancelMultipleOrdersResponse struct {
 
 // OrderListService list of all open orders
 type OrderListService struct {
-	c        *Client
-	instType *string
-	uly      *string
-	instId   *string
-	ordType  *OrderType
-	state    *string
-	after    *string
-	before   *string
-	limit    *string
+	c          *Client
+	instType   *string
+	uly        *string
+	instFamily *string
+	instId     *string
+	ordType    *OrderType
+	state      *string
+	after      *string
+	before     *string
+	limit      *string
 }
 
 // Set intrument type
@@ -286,6 +292,12 @@ func (s *OrderListService) Underlying(uly string) *OrderListService {
 	return s
 }
 
+// Set instrument family, e.g. BTC-USD, replaces the underlying
+func (s *OrderListService) InstrumentFamily(instFamily string) *OrderListService {
+	s.instFamily = &instFamily
+	return s
+}
+
 // Set instrument id
 func (s *OrderListService) InstrumentId(instId string) *OrderListService {
 	s.instId = &instId
@@ -338,6 +350,9 @@ func (s *OrderListService) Do(ctx context.Context, opts ...RequestOption) (res *
 	if s.uly != nil {
 		r.setParam("uly", *s.uly)
 	}
+	if s.instFamily != nil {
+		r.setParam("instFamily", *s.instFamily)
+	}
 	if s.instId != nil {
 		r.setParam("instId", *s.instId)
 	}
@@ -780,39 +795,81 @@ type PlaceAlgoOrderServiceResponse struct {
 	Data []*AlgoOrderDetail `json:"data"`
 }
 
-// CancelAlgoOrderService cancel an algo order
+type CancelAlgoOrder struct {
+	AlgoId string `json:"algoId"`
+	InstId string `json:"instId"`
+}
+
+// CancelAlgoOrderService cancel one or more algo orders
 type CancelAlgoOrderService struct {
 	c      *Client
-	algoId string
-	instId string
+	orders []CancelAlgoOrder
 }
 
-// Set algo Id
+// Set algo Id, kept for single order cancellation together with InstrumentId
 func (s *CancelAlgoOrderService) AlgoId(algoId string) *CancelAlgoOrderService {
-	s.algoId = algoId
+	s.single().AlgoId = algoId
 	return s
 }
 
-// Set instrument Id
+// Set instrument Id, kept for single order cancellation together with AlgoId
 func (s *CancelAlgoOrderService) InstrumentId(instId string) *CancelAlgoOrderService {
-	s.instId = instId
+	s.single().InstId = instId
+	return s
+}
+
+// Set orders list (max 10 per request)
+func (s *CancelAlgoOrderService) OrderList(orders []CancelAlgoOrder) *CancelAlgoOrderService {
+	s.orders = orders
 	return s
 }
 
+func (s *CancelAlgoOrderService) single() *CancelAlgoOrder {
+	if len(s.orders) == 0 {
+		s.orders = append(s.orders, CancelAlgoOrder{})
+	}
+	return &s.orders[0]
+}
+
 // Do send request
 func (s *CancelAlgoOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelAlgoOrderResponse, err error) {
-	// TODO cancel multiple orders
+	return doCancelAlgoOrders(ctx, s.c, "/api/v5/trade/cancel-algos", s.orders, opts...)
+}
 
+// CancelAdvanceAlgoOrderService cancel one or more advance algo orders (iceberg, twap, move_order_stop)
+type CancelAdvanceAlgoOrderService struct {
+	c      *Client
+	orders []CancelAlgoOrder
+}
+
+// Set orders list (max 10 per request)
+func (s *CancelAdvanceAlgoOrderService) OrderList(orders []CancelAlgoOrder) *CancelAdvanceAlgoOrderService {
+	s.orders = orders
+	return s
+}
+
+// Do send request
+func (s *CancelAdvanceAlgoOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelAlgoOrderResponse, err error) {
+	return doCancelAlgoOrders(ctx, s.c, "/api/v5/trade/cancel-advance-algos", s.orders, opts...)
+}
+
+// ErrEmptyOrderList is returned by the algo cancel services when no order was given
+var ErrEmptyOrderList = errors.New("empty order list")
+
+func doCancelAlgoOrders(ctx context.Context, c *Client, endpoint string, orders []CancelAlgoOrder, opts ...RequestOption) (res *CancelAlgoOrderResponse, err error) {
+	if len(orders) == 0 {
+		return nil, ErrEmptyOrderList
+	}
 	r := &request{
 		method:   http.MethodPost,
-		endpoint: "/api/v5/trade/cancel-algos",
+		endpoint: endpoint,
 		secType:  secTypeSigned,
 	}
 
-	r.setBodyParam("algoId", s.algoId)
-	r.setBodyParam("instId", s.instId)
+	postBody, _ := json.Marshal(orders)
+	r.body = bytes.NewBuffer(postBody)
 
-	data, err := s.c.callAPI(ctx, r, opts...)
+	data, err := c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -824,7 +881,7 @@ func (s *CancelAlgoOrderService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
-// Response to CancelAlgoOrderService
+// Response to CancelAlgoOrderService and CancelAdvanceAlgoOrderService
 type CancelAlgoOrderResponse struct {
 	Code string             `json:"code"`
 	Msg  string             `json:"msg"`
@@ -836,3 +893,409 @@ type AlgoOrderDetail struct {
 	SCode  string `json:"sCode"`
 	SMsg   string `json:"sMsg"`
 }
+
+// AlgoOrderListService list of pending algo orders
+type AlgoOrderListService struct {
+	c        *Client
+	ordType  OrderType
+	algoId   *string
+	instType *string
+	instId   *string
+	after    *string
+	before   *string
+	limit    *string
+}
+
+// Set order type (conditional, oco, trigger, move_order_stop, iceberg or twap)
+func (s *AlgoOrderListService) OrderType(ordType OrderType) *AlgoOrderListService {
+	s.ordType = ordType
+	return s
+}
+
+// Set algo Id
+func (s *AlgoOrderListService) AlgoId(algoId string) *AlgoOrderListService {
+	s.algoId = &algoId
+	return s
+}
+
+// Set instrument type
+func (s *AlgoOrderListService) InstrumentType(instType string) *AlgoOrderListService {
+	s.instType = &instType
+	return s
+}
+
+// Set instrument id
+func (s *AlgoOrderListService) InstrumentId(instId string) *AlgoOrderListService {
+	s.instId = &instId
+	return s
+}
+
+// Set after
+func (s *AlgoOrderListService) After(after string) *AlgoOrderListService {
+	s.after = &after
+	return s
+}
+
+// Set before
+func (s *AlgoOrderListService) Before(before string) *AlgoOrderListService {
+	s.before = &before
+	return s
+}
+
+// Set limit
+func (s *AlgoOrderListService) Limit(limit string) *AlgoOrderListService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *AlgoOrderListService) Do(ctx context.Context, opts ...RequestOption) (res *AlgoOrderListServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/trade/orders-algo-pending",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("ordType", string(s.ordType))
+
+	if s.algoId != nil {
+		r.setParam("algoId", *s.algoId)
+	}
+	if s.instType != nil {
+		r.setParam("instType", *s.instType)
+	}
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AlgoOrderListServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AlgoOrderHistoryService list of algo orders in a final state (last 3 months)
+type AlgoOrderHistoryService struct {
+	c        *Client
+	ordType  OrderType
+	state    *AlgoOrderStateType
+	algoId   *string
+	instType *string
+	instId   *string
+	after    *string
+	before   *string
+	limit    *string
+}
+
+// Set order type (conditional, oco, trigger, move_order_stop, iceberg or twap)
+func (s *AlgoOrderHistoryService) OrderType(ordType OrderType) *AlgoOrderHistoryService {
+	s.ordType = ordType
+	return s
+}
+
+// Set state, either state or algo Id is required
+func (s *AlgoOrderHistoryService) State(state AlgoOrderStateType) *AlgoOrderHistoryService {
+	s.state = &state
+	return s
+}
+
+// Set algo Id, either state or algo Id is required
+func (s *AlgoOrderHistoryService) AlgoId(algoId string) *AlgoOrderHistoryService {
+	s.algoId = &algoId
+	return s
+}
+
+// Set instrument type
+func (s *AlgoOrderHistoryService) InstrumentType(instType string) *AlgoOrderHistoryService {
+	s.instType = &instType
+	return s
+}
+
+// Set instrument id
+func (s *AlgoOrderHistoryService) InstrumentId(instId string) *AlgoOrderHistoryService {
+	s.instId = &instId
+	return s
+}
+
+// Set after
+func (s *AlgoOrderHistoryService) After(after string) *AlgoOrderHistoryService {
+	s.after = &after
+	return s
+}
+
+// Set before
+func (s *AlgoOrderHistoryService) Before(before string) *AlgoOrderHistoryService {
+	s.before = &before
+	return s
+}
+
+// Set limit
+func (s *AlgoOrderHistoryService) Limit(limit string) *AlgoOrderHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *AlgoOrderHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *AlgoOrderListServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/trade/orders-algo-history",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("ordType", string(s.ordType))
+
+	if s.state != nil {
+		r.setParam("state", string(*s.state))
+	}
+	if s.algoId != nil {
+		r.setParam("algoId", *s.algoId)
+	}
+	if s.instType != nil {
+		r.setParam("instType", *s.instType)
+	}
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(AlgoOrderListServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to AlgoOrderListService and AlgoOrderHistoryService
+type AlgoOrderListServiceResponse struct {
+	Code string       `json:"code"`
+	Msg  string       `json:"msg"`
+	Data []*AlgoOrder `json:"data"`
+}
+
+type AlgoOrder struct {
+	InstType        string             `json:"instType"`
+	InstId          string             `json:"instId"`
+	OrdId           string             `json:"ordId"`
+	Ccy             string             `json:"ccy"`
+	AlgoId          string             `json:"algoId"`
+	AlgoClOrdId     string             `json:"algoClOrdId"`
+	Sz              string             `json:"sz"`
+	OrdType         OrderType          `json:"ordType"`
+	Side            SideType           `json:"side"`
+	PosSide         PositionSideType   `json:"posSide"`
+	TdMode          TradeMode          `json:"tdMode"`
+	TgtCcy          string             `json:"tgtCcy"`
+	State           AlgoOrderStateType `json:"state"`
+	Lever           string             `json:"lever"`
+	TpTriggerPx     string             `json:"tpTriggerPx"`
+	TpTriggerPxType string             `json:"tpTriggerPxType"`
+	TpOrdPx         string             `json:"tpOrdPx"`
+	SlTriggerPx     string             `json:"slTriggerPx"`
+	SlTriggerPxType string             `json:"slTriggerPxType"`
+	SlOrdPx         string             `json:"slOrdPx"`
+	TriggerPx       string             `json:"triggerPx"`
+	TriggerPxType   string             `json:"triggerPxType"`
+	OrdPx           string             `json:"ordPx"`
+	ActualSz        string             `json:"actualSz"`
+	ActualPx        string             `json:"actualPx"`
+	ActualSide      string             `json:"actualSide"`
+	TriggerTime     string             `json:"triggerTime"`
+	PxVar           string             `json:"pxVar"`
+	PxSpread        string             `json:"pxSpread"`
+	SzLimit         string             `json:"szLimit"`
+	PxLimit         string             `json:"pxLimit"`
+	TimeInterval    string             `json:"timeInterval"`
+	CallbackRatio   string             `json:"callbackRatio"`
+	CallbackSpread  string             `json:"callbackSpread"`
+	ActivePx        string             `json:"activePx"`
+	MoveTriggerPx   string             `json:"moveTriggerPx"`
+	Tag             string             `json:"tag"`
+	CTime           string             `json:"cTime"`
+}
+
+// CancelAllAfterService cancel all pending orders after the countdown timeout
+type CancelAllAfterService struct {
+	c       *Client
+	timeOut int
+}
+
+// Set countdown in seconds, 0 disables it, otherwise it ranges from 10 to 120
+func (s *CancelAllAfterService) TimeOut(timeOut int) *CancelAllAfterService {
+	s.timeOut = timeOut
+	return s
+}
+
+// Do send request
+func (s *CancelAllAfterService) Do(ctx context.Context, opts ...RequestOption) (res *CancelAllAfterServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/trade/cancel-all-after",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("timeOut", strconv.Itoa(s.timeOut))
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CancelAllAfterServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to CancelAllAfterService
+type CancelAllAfterServiceResponse struct {
+	Code string                  `json:"code"`
+	Msg  string                  `json:"msg"`
+	Data []*CancelAllAfterDetail `json:"data"`
+}
+
+type CancelAllAfterDetail struct {
+	TriggerTime string `json:"triggerTime"`
+	Ts          string `json:"ts"`
+}
+
+// StartCancelAllAfterHeartbeat arms the cancel-all-after countdown with timeOut seconds and
+// refreshes it every interval in a background goroutine, so that all pending orders get
+// cancelled by the exchange if the process hangs or loses connectivity.
+// The heartbeat stops when ctx is done and doneC is closed; the countdown is left armed,
+// call NewCancelAllAfterService().TimeOut(0) to disable it explicitly.
+func (c *Client) StartCancelAllAfterHeartbeat(ctx context.Context, timeOut int, interval time.Duration, errHandler ErrHandler) (doneC chan struct{}, err error) {
+	if interval <= 0 || interval >= time.Duration(timeOut)*time.Second {
+		return nil, fmt.Errorf("heartbeat interval %s must be positive and shorter than the %ds timeout", interval, timeOut)
+	}
+
+	refresh := func() error {
+		res, err := c.NewCancelAllAfterService().TimeOut(timeOut).Do(ctx)
+		if err != nil {
+			return err
+		}
+		if res.Code != "0" {
+			return &APIError{Code: res.Code, Message: res.Msg}
+		}
+		return nil
+	}
+
+	// the first call is synchronous so a rejected timeout is reported to the caller
+	err = refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	doneC = make(chan struct{})
+	go func() {
+		defer close(doneC)
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-t.C:
+			}
+
+			err := refresh()
+			if err != nil && ctx.Err() == nil && errHandler != nil {
+				errHandler(err)
+			}
+		}
+	}()
+	return doneC, nil
+}
+
+// MassCancelService cancel all MMP pending orders of an instrument family
+type MassCancelService struct {
+	c            *Client
+	instType     string
+	instFamily   string
+	lockInterval *string
+}
+
+// Set instrument type, only OPTION is supported
+func (s *MassCancelService) InstrumentType(instType string) *MassCancelService {
+	s.instType = instType
+	return s
+}
+
+// Set instrument family
+func (s *MassCancelService) InstrumentFamily(instFamily string) *MassCancelService {
+	s.instFamily = instFamily
+	return s
+}
+
+// Set lock interval in ms, the instrument family cannot be traded while locked
+func (s *MassCancelService) LockInterval(lockInterval string) *MassCancelService {
+	s.lockInterval = &lockInterval
+	return s
+}
+
+// Do send request
+func (s *MassCancelService) Do(ctx context.Context, opts ...RequestOption) (res *MassCancelServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/trade/mass-cancel",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("instType", s.instType)
+	r.setBodyParam("instFamily", s.instFamily)
+
+	if s.lockInterval != nil {
+		r.setBodyParam("lockInterval", *s.lockInterval)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(MassCancelServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to MassCancelService
+type MassCancelServiceResponse struct {
+	Code string              `json:"code"`
+	Msg  string              `json:"msg"`
+	Data []*MassCancelDetail `json:"data"`
+}
+
+type MassCancelDetail struct {
+	Result bool `json:"result"`
+}
@@ -0,0 +1,23 @@
+package okex
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCancelAlgoOrdersEmptyList(t *testing.T) {
+	assert := assert.New(t)
+	c := NewClient("", "", "")
+	c.do = func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request %s", req.URL)
+		return nil, nil
+	}
+
+	_, err := c.NewCancelAlgoOrderService().Do(context.Background())
+	assert.Equal(ErrEmptyOrderList, err)
+	_, err = c.NewCancelAdvanceAlgoOrderService().OrderList(nil).Do(context.Background())
+	assert.Equal(ErrEmptyOrderList, err)
+}
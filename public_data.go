@@ -3,15 +3,20 @@ package okex
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 // GetInstrumentsService
 type GetInstrumentsService struct {
-	c        *Client
-	instType string
-	uly      *string
-	instId   *string
+	c          *Client
+	instType   string
+	uly        *string
+	instFamily *string
+	instId     *string
 }
 
 // Set instrument type
@@ -26,6 +31,12 @@ func (s *GetInstrumentsService) Underlying(uly string) *GetInstrumentsService {
 	return s
 }
 
+// Set instrument family, e.g. BTC-USD, replaces the underlying
+func (s *GetInstrumentsService) InstrumentFamily(instFamily string) *GetInstrumentsService {
+	s.instFamily = &instFamily
+	return s
+}
+
 // Set instrument id
 func (s *GetInstrumentsService) InstrumentId(instId string) *GetInstrumentsService {
 	s.instId = &instId
@@ -44,6 +55,9 @@ func (s *GetInstrumentsService) Do(ctx context.Context, opts ...RequestOption) (
 	if s.uly != nil {
 		r.setParam("uly", *s.uly)
 	}
+	if s.instFamily != nil {
+		r.setParam("instFamily", *s.instFamily)
+	}
 	if s.instId != nil {
 		r.setParam("instId", *s.instId)
 	}
@@ -68,27 +82,28 @@ type GetInstrumentsServiceRespone struct {
 }
 
 type InstrumentDetail struct {
-	InstType  string `json:"instType"`
-	InstId    string `json:"instId"`
-	Uly       string `json:"uly"`
-	Category  string `json:"category"`
-	BaseCcy   string `json:"baseCcy"`
-	QuoteCcy  string `json:"quoteCcy"`
-	SettleCcy string `json:"settleCcy"`
-	CtVal     string `json:"ctVal"`
-	CtMult    string `json:"ctMult"`
-	CtValCcy  string `json:"ctValCcy"`
-	OptType   string `json:"optType"`
-	Stk       string `json:"stk"`
-	ListTime  string `json:"listTime"`
-	ExpTime   string `json:"expTime"`
-	Lever     string `json:"lever"`
-	TickSz    string `json:"tickSz"`
-	LotSz     string `json:"lotSz"`
-	MinSz     string `json:"minSz"`
-	CtType    string `json:"ctType"`
-	Alias     string `json:"alias"`
-	State     string `json:"state"`
+	InstType   string `json:"instType"`
+	InstId     string `json:"instId"`
+	Uly        string `json:"uly"`
+	InstFamily string `json:"instFamily"`
+	Category   string `json:"category"`
+	BaseCcy    string `json:"baseCcy"`
+	QuoteCcy   string `json:"quoteCcy"`
+	SettleCcy  string `json:"settleCcy"`
+	CtVal      string `json:"ctVal"`
+	CtMult     string `json:"ctMult"`
+	CtValCcy   string `json:"ctValCcy"`
+	OptType    string `json:"optType"`
+	Stk        string `json:"stk"`
+	ListTime   string `json:"listTime"`
+	ExpTime    string `json:"expTime"`
+	Lever      string `json:"lever"`
+	TickSz     string `json:"tickSz"`
+	LotSz      string `json:"lotSz"`
+	MinSz      string `json:"minSz"`
+	CtType     string `json:"ctType"`
+	Alias      string `json:"alias"`
+	State      string `json:"state"`
 }
 
 // GetDeliveryExerciseHistoryService
@@ -180,3 +195,1606 @@ type DeliveryExcerciseDetail struct {
 	InstId string `json:"instId"`
 	Px     string `json:"px"`
 }
+
+// GetOpenInterestService get the open interest of swaps, futures and options
+type GetOpenInterestService struct {
+	c        *Client
+	instType string
+	uly      *string
+	instId   *string
+}
+
+// Set instrument type, SWAP, FUTURES or OPTION
+func (s *GetOpenInterestService) InstrumentType(instType string) *GetOpenInterestService {
+	s.instType = instType
+	return s
+}
+
+// Set underlying
+func (s *GetOpenInterestService) Underlying(uly string) *GetOpenInterestService {
+	s.uly = &uly
+	return s
+}
+
+// Set instrument id
+func (s *GetOpenInterestService) InstrumentId(instId string) *GetOpenInterestService {
+	s.instId = &instId
+	return s
+}
+
+// Do send request
+func (s *GetOpenInterestService) Do(ctx context.Context, opts ...RequestOption) (res *GetOpenInterestServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/open-interest",
+	}
+
+	r.setParam("instType", s.instType)
+
+	if s.uly != nil {
+		r.setParam("uly", *s.uly)
+	}
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetOpenInterestServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetOpenInterestService
+type GetOpenInterestServiceResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data []*OpenInterest `json:"data"`
+}
+
+// OpenInterest in contracts (Oi) and in currency (OiCcy)
+type OpenInterest struct {
+	InstType string
+	InstId   string
+	Oi       float64
+	OiCcy    float64
+	Ts       time.Time
+}
+
+// UnmarshalJSON parses the numeric and time fields
+func (o *OpenInterest) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		InstType string `json:"instType"`
+		InstId   string `json:"instId"`
+		Oi       string `json:"oi"`
+		OiCcy    string `json:"oiCcy"`
+		Ts       string `json:"ts"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*o = OpenInterest{
+		InstType: raw.InstType,
+		InstId:   raw.InstId,
+		Oi:       p.float("oi", raw.Oi),
+		OiCcy:    p.float("oiCcy", raw.OiCcy),
+		Ts:       p.time("ts", raw.Ts),
+	}
+	return p.err
+}
+
+// GetFundingRateService get the current and the predicted funding rate of a perpetual swap
+type GetFundingRateService struct {
+	c      *Client
+	instId string
+}
+
+// Set instrument id, e.g. BTC-USD-SWAP
+func (s *GetFundingRateService) InstrumentId(instId string) *GetFundingRateService {
+	s.instId = instId
+	return s
+}
+
+// Do send request
+func (s *GetFundingRateService) Do(ctx context.Context, opts ...RequestOption) (res *GetFundingRateServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/funding-rate",
+	}
+
+	r.setParam("instId", s.instId)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetFundingRateServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetFundingRateService
+type GetFundingRateServiceResponse struct {
+	Code string         `json:"code"`
+	Msg  string         `json:"msg"`
+	Data []*FundingRate `json:"data"`
+}
+
+// FundingRate is the rate settled at FundingTime and the predicted one settled at NextFundingTime.
+// NextFundingRate is nil for the instruments without a predicted rate.
+type FundingRate struct {
+	InstType        string
+	InstId          string
+	FundingRate     float64
+	NextFundingRate *float64
+	FundingTime     time.Time
+	NextFundingTime time.Time
+}
+
+// UnmarshalJSON parses the numeric and time fields
+func (f *FundingRate) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		InstType        string `json:"instType"`
+		InstId          string `json:"instId"`
+		FundingRate     string `json:"fundingRate"`
+		NextFundingRate string `json:"nextFundingRate"`
+		FundingTime     string `json:"fundingTime"`
+		NextFundingTime string `json:"nextFundingTime"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*f = FundingRate{
+		InstType:        raw.InstType,
+		InstId:          raw.InstId,
+		FundingRate:     p.float("fundingRate", raw.FundingRate),
+		NextFundingRate: p.optionalFloat("nextFundingRate", raw.NextFundingRate),
+		FundingTime:     p.time("fundingTime", raw.FundingTime),
+		NextFundingTime: p.time("nextFundingTime", raw.NextFundingTime),
+	}
+	return p.err
+}
+
+// GetFundingRateHistoryService get the funding rates settled in the last 3 months
+type GetFundingRateHistoryService struct {
+	c      *Client
+	instId string
+	after  *string
+	before *string
+	limit  *string
+}
+
+// Set instrument id, e.g. BTC-USD-SWAP
+func (s *GetFundingRateHistoryService) InstrumentId(instId string) *GetFundingRateHistoryService {
+	s.instId = instId
+	return s
+}
+
+// Set after, return records older than the fundingTime
+func (s *GetFundingRateHistoryService) After(after string) *GetFundingRateHistoryService {
+	s.after = &after
+	return s
+}
+
+// Set before, return records newer than the fundingTime
+func (s *GetFundingRateHistoryService) Before(before string) *GetFundingRateHistoryService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 100
+func (s *GetFundingRateHistoryService) Limit(limit string) *GetFundingRateHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetFundingRateHistoryService) Do(ctx context.Context, opts ...RequestOption) (res *GetFundingRateHistoryServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/funding-rate-history",
+	}
+
+	r.setParam("instId", s.instId)
+
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetFundingRateHistoryServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetFundingRateHistoryService
+type GetFundingRateHistoryServiceResponse struct {
+	Code string                `json:"code"`
+	Msg  string                `json:"msg"`
+	Data []*FundingRateHistory `json:"data"`
+}
+
+// FundingRateHistory is a settled funding, RealizedRate is the rate actually charged
+type FundingRateHistory struct {
+	InstType     string
+	InstId       string
+	FundingRate  float64
+	RealizedRate float64
+	FundingTime  time.Time
+}
+
+// UnmarshalJSON parses the numeric and time fields
+func (f *FundingRateHistory) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		InstType     string `json:"instType"`
+		InstId       string `json:"instId"`
+		FundingRate  string `json:"fundingRate"`
+		RealizedRate string `json:"realizedRate"`
+		FundingTime  string `json:"fundingTime"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*f = FundingRateHistory{
+		InstType:     raw.InstType,
+		InstId:       raw.InstId,
+		FundingRate:  p.float("fundingRate", raw.FundingRate),
+		RealizedRate: p.float("realizedRate", raw.RealizedRate),
+		FundingTime:  p.time("fundingTime", raw.FundingTime),
+	}
+	return p.err
+}
+
+// GetPriceLimitService get the highest buy and lowest sell price allowed for an instrument
+type GetPriceLimitService struct {
+	c      *Client
+	instId string
+}
+
+// Set instrument id
+func (s *GetPriceLimitService) InstrumentId(instId string) *GetPriceLimitService {
+	s.instId = instId
+	return s
+}
+
+// Do send request
+func (s *GetPriceLimitService) Do(ctx context.Context, opts ...RequestOption) (res *GetPriceLimitServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/price-limit",
+	}
+
+	r.setParam("instId", s.instId)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetPriceLimitServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetPriceLimitService
+type GetPriceLimitServiceResponse struct {
+	Code string        `json:"code"`
+	Msg  string        `json:"msg"`
+	Data []*PriceLimit `json:"data"`
+}
+
+type PriceLimit struct {
+	InstType string
+	InstId   string
+	BuyLmt   float64
+	SellLmt  float64
+	Ts       time.Time
+}
+
+// UnmarshalJSON parses the numeric and time fields
+func (l *PriceLimit) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		InstType string `json:"instType"`
+		InstId   string `json:"instId"`
+		BuyLmt   string `json:"buyLmt"`
+		SellLmt  string `json:"sellLmt"`
+		Ts       string `json:"ts"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*l = PriceLimit{
+		InstType: raw.InstType,
+		InstId:   raw.InstId,
+		BuyLmt:   p.float("buyLmt", raw.BuyLmt),
+		SellLmt:  p.float("sellLmt", raw.SellLmt),
+		Ts:       p.time("ts", raw.Ts),
+	}
+	return p.err
+}
+
+// GetEstimatedPriceService get the estimated delivery or exercise price, available an hour before delivery or exercise
+type GetEstimatedPriceService struct {
+	c      *Client
+	instId string
+}
+
+// Set instrument id, FUTURES or OPTION only
+func (s *GetEstimatedPriceService) InstrumentId(instId string) *GetEstimatedPriceService {
+	s.instId = instId
+	return s
+}
+
+// Do send request
+func (s *GetEstimatedPriceService) Do(ctx context.Context, opts ...RequestOption) (res *GetEstimatedPriceServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/estimated-price",
+	}
+
+	r.setParam("instId", s.instId)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetEstimatedPriceServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetEstimatedPriceService
+type GetEstimatedPriceServiceResponse struct {
+	Code string            `json:"code"`
+	Msg  string            `json:"msg"`
+	Data []*EstimatedPrice `json:"data"`
+}
+
+type EstimatedPrice struct {
+	InstType string
+	InstId   string
+	SettlePx float64
+	Ts       time.Time
+}
+
+// UnmarshalJSON parses the numeric and time fields
+func (e *EstimatedPrice) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		InstType string `json:"instType"`
+		InstId   string `json:"instId"`
+		SettlePx string `json:"settlePx"`
+		Ts       string `json:"ts"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*e = EstimatedPrice{
+		InstType: raw.InstType,
+		InstId:   raw.InstId,
+		SettlePx: p.float("settlePx", raw.SettlePx),
+		Ts:       p.time("ts", raw.Ts),
+	}
+	return p.err
+}
+
+// GetMarkPriceService get the mark price of margin, swap, futures and option instruments
+type GetMarkPriceService struct {
+	c        *Client
+	instType string
+	uly      *string
+	instId   *string
+}
+
+// Set instrument type, MARGIN, SWAP, FUTURES or OPTION
+func (s *GetMarkPriceService) InstrumentType(instType string) *GetMarkPriceService {
+	s.instType = instType
+	return s
+}
+
+// Set underlying
+func (s *GetMarkPriceService) Underlying(uly string) *GetMarkPriceService {
+	s.uly = &uly
+	return s
+}
+
+// Set instrument id
+func (s *GetMarkPriceService) InstrumentId(instId string) *GetMarkPriceService {
+	s.instId = &instId
+	return s
+}
+
+// Do send request
+func (s *GetMarkPriceService) Do(ctx context.Context, opts ...RequestOption) (res *GetMarkPriceServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/mark-price",
+	}
+
+	r.setParam("instType", s.instType)
+
+	if s.uly != nil {
+		r.setParam("uly", *s.uly)
+	}
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetMarkPriceServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetMarkPriceService
+type GetMarkPriceServiceResponse struct {
+	Code string       `json:"code"`
+	Msg  string       `json:"msg"`
+	Data []*MarkPrice `json:"data"`
+}
+
+type MarkPrice struct {
+	InstType string
+	InstId   string
+	MarkPx   float64
+	Ts       time.Time
+}
+
+// UnmarshalJSON parses the numeric and time fields
+func (m *MarkPrice) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		InstType string `json:"instType"`
+		InstId   string `json:"instId"`
+		MarkPx   string `json:"markPx"`
+		Ts       string `json:"ts"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*m = MarkPrice{
+		InstType: raw.InstType,
+		InstId:   raw.InstId,
+		MarkPx:   p.float("markPx", raw.MarkPx),
+		Ts:       p.time("ts", raw.Ts),
+	}
+	return p.err
+}
+
+// GetPositionTiersService get the position tiers, i.e. the maximum leverage and margin ratios by position size
+type GetPositionTiersService struct {
+	c          *Client
+	instType   string
+	tdMode     TradeMode
+	uly        *string
+	instFamily *string
+	instId     *string
+	ccy        *string
+	tier       *string
+}
+
+// Set instrument type, MARGIN, SWAP, FUTURES or OPTION
+func (s *GetPositionTiersService) InstrumentType(instType string) *GetPositionTiersService {
+	s.instType = instType
+	return s
+}
+
+// Set trade mode, cross or isolated
+func (s *GetPositionTiersService) TradeMode(tdMode TradeMode) *GetPositionTiersService {
+	s.tdMode = tdMode
+	return s
+}
+
+// Set underlying, either underlying or instrument family is required for SWAP, FUTURES and OPTION
+func (s *GetPositionTiersService) Underlying(uly string) *GetPositionTiersService {
+	s.uly = &uly
+	return s
+}
+
+// Set instrument family, e.g. BTC-USD, replaces the underlying
+func (s *GetPositionTiersService) InstrumentFamily(instFamily string) *GetPositionTiersService {
+	s.instFamily = &instFamily
+	return s
+}
+
+// Set instrument id, required for MARGIN
+func (s *GetPositionTiersService) InstrumentId(instId string) *GetPositionTiersService {
+	s.instId = &instId
+	return s
+}
+
+// Set margin currency, MARGIN cross only
+func (s *GetPositionTiersService) Currency(ccy string) *GetPositionTiersService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set tier
+func (s *GetPositionTiersService) Tier(tier string) *GetPositionTiersService {
+	s.tier = &tier
+	return s
+}
+
+// Do send request
+func (s *GetPositionTiersService) Do(ctx context.Context, opts ...RequestOption) (res *GetPositionTiersServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/position-tiers",
+	}
+
+	r.setParam("instType", s.instType)
+	r.setParam("tdMode", string(s.tdMode))
+
+	if s.uly != nil {
+		r.setParam("uly", *s.uly)
+	}
+	if s.instFamily != nil {
+		r.setParam("instFamily", *s.instFamily)
+	}
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.tier != nil {
+		r.setParam("tier", *s.tier)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetPositionTiersServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetPositionTiersService
+type GetPositionTiersServiceResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data []*PositionTier `json:"data"`
+}
+
+// PositionTier applies to positions sized between MinSz and MaxSz, in contracts for derivatives
+// and in base currency for MARGIN. Mmr and Imr are the maintenance and initial margin ratios.
+type PositionTier struct {
+	Uly          string
+	InstFamily   string
+	InstId       string
+	Tier         int
+	MinSz        float64
+	MaxSz        float64
+	Mmr          float64
+	Imr          float64
+	MaxLever     float64
+	OptMgnFactor float64
+	QuoteMaxLoan float64
+	BaseMaxLoan  float64
+}
+
+// UnmarshalJSON parses the numeric fields
+func (t *PositionTier) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Uly          string `json:"uly"`
+		InstFamily   string `json:"instFamily"`
+		InstId       string `json:"instId"`
+		Tier         string `json:"tier"`
+		MinSz        string `json:"minSz"`
+		MaxSz        string `json:"maxSz"`
+		Mmr          string `json:"mmr"`
+		Imr          string `json:"imr"`
+		MaxLever     string `json:"maxLever"`
+		OptMgnFactor string `json:"optMgnFactor"`
+		QuoteMaxLoan string `json:"quoteMaxLoan"`
+		BaseMaxLoan  string `json:"baseMaxLoan"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*t = PositionTier{
+		Uly:          raw.Uly,
+		InstFamily:   raw.InstFamily,
+		InstId:       raw.InstId,
+		Tier:         p.int("tier", raw.Tier),
+		MinSz:        p.float("minSz", raw.MinSz),
+		MaxSz:        p.float("maxSz", raw.MaxSz),
+		Mmr:          p.float("mmr", raw.Mmr),
+		Imr:          p.float("imr", raw.Imr),
+		MaxLever:     p.float("maxLever", raw.MaxLever),
+		OptMgnFactor: p.float("optMgnFactor", raw.OptMgnFactor),
+		QuoteMaxLoan: p.float("quoteMaxLoan", raw.QuoteMaxLoan),
+		BaseMaxLoan:  p.float("baseMaxLoan", raw.BaseMaxLoan),
+	}
+	return p.err
+}
+
+// GetDiscountRateService get the discount rates applied to the currencies counted in the account equity
+// and their interest-free quota
+type GetDiscountRateService struct {
+	c          *Client
+	ccy        *string
+	discountLv *string
+}
+
+// Set currency
+func (s *GetDiscountRateService) Currency(ccy string) *GetDiscountRateService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set discount level, 1 to 6
+func (s *GetDiscountRateService) DiscountLevel(discountLv string) *GetDiscountRateService {
+	s.discountLv = &discountLv
+	return s
+}
+
+// Do send request
+func (s *GetDiscountRateService) Do(ctx context.Context, opts ...RequestOption) (res *GetDiscountRateServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/discount-rate-interest-free-quota",
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.discountLv != nil {
+		r.setParam("discountLv", *s.discountLv)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetDiscountRateServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetDiscountRateService
+type GetDiscountRateServiceResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data []*DiscountRate `json:"data"`
+}
+
+// DiscountRate of a currency, Amt is the interest-free quota
+type DiscountRate struct {
+	Ccy          string
+	Amt          float64
+	DiscountLv   string
+	DiscountInfo []*DiscountTier
+}
+
+// DiscountTier applies DiscountRate to the amount between MinAmt and MaxAmt, an empty MaxAmt is parsed as 0 and means no upper bound
+type DiscountTier struct {
+	DiscountRate float64
+	MinAmt       float64
+	MaxAmt       float64
+}
+
+// UnmarshalJSON parses the numeric fields
+func (d *DiscountRate) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Ccy          string `json:"ccy"`
+		Amt          string `json:"amt"`
+		DiscountLv   string `json:"discountLv"`
+		DiscountInfo []struct {
+			DiscountRate string `json:"discountRate"`
+			MinAmt       string `json:"minAmt"`
+			MaxAmt       string `json:"maxAmt"`
+		} `json:"discountInfo"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*d = DiscountRate{
+		Ccy:        raw.Ccy,
+		Amt:        p.float("amt", raw.Amt),
+		DiscountLv: raw.DiscountLv,
+	}
+	for _, info := range raw.DiscountInfo {
+		d.DiscountInfo = append(d.DiscountInfo, &DiscountTier{
+			DiscountRate: p.float("discountRate", info.DiscountRate),
+			MinAmt:       p.float("minAmt", info.MinAmt),
+			MaxAmt:       p.float("maxAmt", info.MaxAmt),
+		})
+	}
+	return p.err
+}
+
+// GetInterestRateLoanQuotaService get the basic borrowing rates and quotas and their multipliers per user level
+type GetInterestRateLoanQuotaService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetInterestRateLoanQuotaService) Do(ctx context.Context, opts ...RequestOption) (res *GetInterestRateLoanQuotaServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/interest-rate-loan-quota",
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetInterestRateLoanQuotaServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetInterestRateLoanQuotaService
+type GetInterestRateLoanQuotaServiceResponse struct {
+	Code string                   `json:"code"`
+	Msg  string                   `json:"msg"`
+	Data []*InterestRateLoanQuota `json:"data"`
+}
+
+type InterestRateLoanQuota struct {
+	Basic   []*BasicLoanQuota `json:"basic"`
+	Vip     []*LevelLoanQuota `json:"vip"`
+	Regular []*LevelLoanQuota `json:"regular"`
+}
+
+// BasicLoanQuota is the daily borrowing rate and the basic loan quota of a currency
+type BasicLoanQuota struct {
+	Ccy   string
+	Rate  float64
+	Quota float64
+}
+
+// UnmarshalJSON parses the numeric fields
+func (q *BasicLoanQuota) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Ccy   string `json:"ccy"`
+		Rate  string `json:"rate"`
+		Quota string `json:"quota"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*q = BasicLoanQuota{
+		Ccy:   raw.Ccy,
+		Rate:  p.float("rate", raw.Rate),
+		Quota: p.float("quota", raw.Quota),
+	}
+	return p.err
+}
+
+// LevelLoanQuota holds the loan quota multiplier and the interest rate discount of a user level
+type LevelLoanQuota struct {
+	Level         string
+	LoanQuotaCoef float64
+	IrDiscount    float64
+}
+
+// UnmarshalJSON parses the numeric fields
+func (q *LevelLoanQuota) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Level         string `json:"level"`
+		LoanQuotaCoef string `json:"loanQuotaCoef"`
+		IrDiscount    string `json:"irDiscount"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*q = LevelLoanQuota{
+		Level:         raw.Level,
+		LoanQuotaCoef: p.float("loanQuotaCoef", raw.LoanQuotaCoef),
+		IrDiscount:    p.float("irDiscount", raw.IrDiscount),
+	}
+	return p.err
+}
+
+// GetVIPInterestRateLoanQuotaService get the VIP loan quota of each currency per VIP level
+type GetVIPInterestRateLoanQuotaService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetVIPInterestRateLoanQuotaService) Do(ctx context.Context, opts ...RequestOption) (res *GetVIPInterestRateLoanQuotaServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/vip-interest-rate-loan-quota",
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetVIPInterestRateLoanQuotaServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetVIPInterestRateLoanQuotaService
+type GetVIPInterestRateLoanQuotaServiceResponse struct {
+	Code string                      `json:"code"`
+	Msg  string                      `json:"msg"`
+	Data []*VIPInterestRateLoanQuota `json:"data"`
+}
+
+// VIPInterestRateLoanQuota is the VIP loan quota of a currency, Quota being the basic one
+type VIPInterestRateLoanQuota struct {
+	Ccy       string
+	Quota     float64
+	LevelList []*VIPLoanQuota
+}
+
+// VIPLoanQuota is the loan quota of a VIP level
+type VIPLoanQuota struct {
+	Level     string
+	LoanQuota float64
+}
+
+// UnmarshalJSON parses the numeric fields
+func (q *VIPInterestRateLoanQuota) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Ccy       string `json:"ccy"`
+		Quota     string `json:"quota"`
+		LevelList []struct {
+			Level     string `json:"level"`
+			LoanQuota string `json:"loanQuota"`
+		} `json:"levelList"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*q = VIPInterestRateLoanQuota{
+		Ccy:   raw.Ccy,
+		Quota: p.float("quota", raw.Quota),
+	}
+	for _, l := range raw.LevelList {
+		q.LevelList = append(q.LevelList, &VIPLoanQuota{
+			Level:     l.Level,
+			LoanQuota: p.float("loanQuota", l.LoanQuota),
+		})
+	}
+	return p.err
+}
+
+// LiquidationStateType define the state of liquidation orders
+type LiquidationStateType string
+
+const (
+	LiquidationStateUnfilled LiquidationStateType = "unfilled"
+	LiquidationStateFilled   LiquidationStateType = "filled"
+)
+
+// GetLiquidationOrdersService get the liquidation orders of the last 7 days
+type GetLiquidationOrdersService struct {
+	c        *Client
+	instType string
+	mgnMode  *TradeMode
+	instId   *string
+	ccy      *string
+	uly      *string
+	alias    *string
+	state    *LiquidationStateType
+	after    *string
+	before   *string
+	limit    *string
+}
+
+// Set instrument type, MARGIN, SWAP, FUTURES or OPTION
+func (s *GetLiquidationOrdersService) InstrumentType(instType string) *GetLiquidationOrdersService {
+	s.instType = instType
+	return s
+}
+
+// Set margin mode, cross or isolated
+func (s *GetLiquidationOrdersService) MarginMode(mgnMode TradeMode) *GetLiquidationOrdersService {
+	s.mgnMode = &mgnMode
+	return s
+}
+
+// Set instrument id, MARGIN only
+func (s *GetLiquidationOrdersService) InstrumentId(instId string) *GetLiquidationOrdersService {
+	s.instId = &instId
+	return s
+}
+
+// Set currency, MARGIN cross only
+func (s *GetLiquidationOrdersService) Currency(ccy string) *GetLiquidationOrdersService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set underlying, required for SWAP, FUTURES and OPTION
+func (s *GetLiquidationOrdersService) Underlying(uly string) *GetLiquidationOrdersService {
+	s.uly = &uly
+	return s
+}
+
+// Set alias, this_week, next_week, quarter or next_quarter, FUTURES only
+func (s *GetLiquidationOrdersService) Alias(alias string) *GetLiquidationOrdersService {
+	s.alias = &alias
+	return s
+}
+
+// Set state, SWAP and FUTURES only
+func (s *GetLiquidationOrdersService) State(state LiquidationStateType) *GetLiquidationOrdersService {
+	s.state = &state
+	return s
+}
+
+// Set after, return records older than the ts
+func (s *GetLiquidationOrdersService) After(after string) *GetLiquidationOrdersService {
+	s.after = &after
+	return s
+}
+
+// Set before, return records newer than the ts
+func (s *GetLiquidationOrdersService) Before(before string) *GetLiquidationOrdersService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 100
+func (s *GetLiquidationOrdersService) Limit(limit string) *GetLiquidationOrdersService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetLiquidationOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *GetLiquidationOrdersServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/liquidation-orders",
+	}
+
+	r.setParam("instType", s.instType)
+
+	if s.mgnMode != nil {
+		r.setParam("mgnMode", string(*s.mgnMode))
+	}
+	if s.instId != nil {
+		r.setParam("instId", *s.instId)
+	}
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.uly != nil {
+		r.setParam("uly", *s.uly)
+	}
+	if s.alias != nil {
+		r.setParam("alias", *s.alias)
+	}
+	if s.state != nil {
+		r.setParam("state", string(*s.state))
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetLiquidationOrdersServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetLiquidationOrdersService
+type GetLiquidationOrdersServiceResponse struct {
+	Code string              `json:"code"`
+	Msg  string              `json:"msg"`
+	Data []*LiquidationOrder `json:"data"`
+}
+
+type LiquidationOrder struct {
+	InstType  string
+	InstId    string
+	Uly       string
+	TotalLoss float64
+	Details   []*LiquidationOrderDetail
+}
+
+// LiquidationOrderDetail is a liquidated position, BkPx being its bankruptcy price
+type LiquidationOrderDetail struct {
+	Side    SideType
+	PosSide PositionSideType
+	BkPx    float64
+	Sz      float64
+	BkLoss  float64
+	Ccy     string
+	Ts      time.Time
+}
+
+// UnmarshalJSON parses the numeric and time fields
+func (o *LiquidationOrder) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		InstType  string `json:"instType"`
+		InstId    string `json:"instId"`
+		Uly       string `json:"uly"`
+		TotalLoss string `json:"totalLoss"`
+		Details   []struct {
+			Side    SideType         `json:"side"`
+			PosSide PositionSideType `json:"posSide"`
+			BkPx    string           `json:"bkPx"`
+			Sz      string           `json:"sz"`
+			BkLoss  string           `json:"bkLoss"`
+			Ccy     string           `json:"ccy"`
+			Ts      string           `json:"ts"`
+		} `json:"details"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*o = LiquidationOrder{
+		InstType:  raw.InstType,
+		InstId:    raw.InstId,
+		Uly:       raw.Uly,
+		TotalLoss: p.float("totalLoss", raw.TotalLoss),
+	}
+	for _, d := range raw.Details {
+		o.Details = append(o.Details, &LiquidationOrderDetail{
+			Side:    d.Side,
+			PosSide: d.PosSide,
+			BkPx:    p.float("bkPx", d.BkPx),
+			Sz:      p.float("sz", d.Sz),
+			BkLoss:  p.float("bkLoss", d.BkLoss),
+			Ccy:     d.Ccy,
+			Ts:      p.time("ts", d.Ts),
+		})
+	}
+	return p.err
+}
+
+// InsuranceFundType define the type of insurance fund records
+type InsuranceFundType string
+
+const (
+	InsuranceFundTypeRegularUpdate   InsuranceFundType = "regular_update"
+	InsuranceFundTypeLiquidation     InsuranceFundType = "liquidation_balance_deposit"
+	InsuranceFundTypeBankruptcyLoss  InsuranceFundType = "bankruptcy_loss"
+	InsuranceFundTypePlatformRevenue InsuranceFundType = "platform_revenue"
+)
+
+// GetInsuranceFundService get the balance of the insurance fund
+type GetInsuranceFundService struct {
+	c        *Client
+	instType string
+	fundType *InsuranceFundType
+	uly      *string
+	ccy      *string
+	after    *string
+	before   *string
+	limit    *string
+}
+
+// Set instrument type, MARGIN, SWAP, FUTURES or OPTION
+func (s *GetInsuranceFundService) InstrumentType(instType string) *GetInsuranceFundService {
+	s.instType = instType
+	return s
+}
+
+// Set record type
+func (s *GetInsuranceFundService) Type(fundType InsuranceFundType) *GetInsuranceFundService {
+	s.fundType = &fundType
+	return s
+}
+
+// Set underlying, required for SWAP, FUTURES and OPTION
+func (s *GetInsuranceFundService) Underlying(uly string) *GetInsuranceFundService {
+	s.uly = &uly
+	return s
+}
+
+// Set currency, required for MARGIN
+func (s *GetInsuranceFundService) Currency(ccy string) *GetInsuranceFundService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set after, return records older than the ts
+func (s *GetInsuranceFundService) After(after string) *GetInsuranceFundService {
+	s.after = &after
+	return s
+}
+
+// Set before, return records newer than the ts
+func (s *GetInsuranceFundService) Before(before string) *GetInsuranceFundService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 100
+func (s *GetInsuranceFundService) Limit(limit string) *GetInsuranceFundService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetInsuranceFundService) Do(ctx context.Context, opts ...RequestOption) (res *GetInsuranceFundServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/insurance-fund",
+	}
+
+	r.setParam("instType", s.instType)
+
+	if s.fundType != nil {
+		r.setParam("type", string(*s.fundType))
+	}
+	if s.uly != nil {
+		r.setParam("uly", *s.uly)
+	}
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetInsuranceFundServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetInsuranceFundService
+type GetInsuranceFundServiceResponse struct {
+	Code string           `json:"code"`
+	Msg  string           `json:"msg"`
+	Data []*InsuranceFund `json:"data"`
+}
+
+// InsuranceFund holds the total balance of the fund in USD and its records
+type InsuranceFund struct {
+	Total   float64
+	Details []*InsuranceFundDetail
+}
+
+// InsuranceFundDetail is a change of the fund balance, Amt being the change
+type InsuranceFundDetail struct {
+	Balance float64
+	Amt     float64
+	Ccy     string
+	Type    InsuranceFundType
+	Ts      time.Time
+}
+
+// UnmarshalJSON parses the numeric and time fields
+func (f *InsuranceFund) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Total   string `json:"total"`
+		Details []struct {
+			Balance string            `json:"balance"`
+			Amt     string            `json:"amt"`
+			Ccy     string            `json:"ccy"`
+			Type    InsuranceFundType `json:"type"`
+			Ts      string            `json:"ts"`
+		} `json:"details"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*f = InsuranceFund{
+		Total: p.float("total", raw.Total),
+	}
+	for _, d := range raw.Details {
+		f.Details = append(f.Details, &InsuranceFundDetail{
+			Balance: p.float("balance", d.Balance),
+			Amt:     p.float("amt", d.Amt),
+			Ccy:     d.Ccy,
+			Type:    d.Type,
+			Ts:      p.time("ts", d.Ts),
+		})
+	}
+	return p.err
+}
+
+// GetOptionSummaryService get the greeks and implied volatilities of the options of an underlying
+type GetOptionSummaryService struct {
+	c          *Client
+	uly        *string
+	instFamily *string
+	expTime    *string
+}
+
+// Set underlying, either underlying or instrument family is required
+func (s *GetOptionSummaryService) Underlying(uly string) *GetOptionSummaryService {
+	s.uly = &uly
+	return s
+}
+
+// Set instrument family, e.g. BTC-USD, replaces the underlying
+func (s *GetOptionSummaryService) InstrumentFamily(instFamily string) *GetOptionSummaryService {
+	s.instFamily = &instFamily
+	return s
+}
+
+// Set expiry date, format YYMMDD, e.g. 200527
+func (s *GetOptionSummaryService) ExpiryTime(expTime string) *GetOptionSummaryService {
+	s.expTime = &expTime
+	return s
+}
+
+// Do send request
+func (s *GetOptionSummaryService) Do(ctx context.Context, opts ...RequestOption) (res *GetOptionSummaryServiceResponse, err error) {
+	if s.uly == nil && s.instFamily == nil {
+		return nil, errors.New("either underlying or instrument family is required")
+	}
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/opt-summary",
+	}
+
+	if s.uly != nil {
+		r.setParam("uly", *s.uly)
+	}
+	if s.instFamily != nil {
+		r.setParam("instFamily", *s.instFamily)
+	}
+	if s.expTime != nil {
+		r.setParam("expTime", *s.expTime)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetOptionSummaryServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetOptionSummaryService
+type GetOptionSummaryServiceResponse struct {
+	Code string           `json:"code"`
+	Msg  string           `json:"msg"`
+	Data []*OptionSummary `json:"data"`
+}
+
+// OptionSummary holds the greeks of an option in coins (Delta, Gamma, Vega, Theta) and in
+// USD, Black-Scholes (DeltaBS, GammaBS, VegaBS, ThetaBS), and its volatilities
+type OptionSummary struct {
+	InstType   string
+	InstId     string
+	Uly        string
+	InstFamily string
+	Delta      float64
+	Gamma      float64
+	Vega       float64
+	Theta      float64
+	DeltaBS    float64
+	GammaBS    float64
+	VegaBS     float64
+	ThetaBS    float64
+	Lever      float64
+	// MarkVol, BidVol and AskVol are the implied volatilities at the mark, bid and ask prices
+	MarkVol float64
+	BidVol  float64
+	AskVol  float64
+	RealVol float64
+	VolLv   float64
+	FwdPx   float64
+	Ts      time.Time
+}
+
+// UnmarshalJSON parses the numeric and time fields
+func (o *OptionSummary) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		InstType   string `json:"instType"`
+		InstId     string `json:"instId"`
+		Uly        string `json:"uly"`
+		InstFamily string `json:"instFamily"`
+		Delta      string `json:"delta"`
+		Gamma      string `json:"gamma"`
+		Vega       string `json:"vega"`
+		Theta      string `json:"theta"`
+		DeltaBS    string `json:"deltaBS"`
+		GammaBS    string `json:"gammaBS"`
+		VegaBS     string `json:"vegaBS"`
+		ThetaBS    string `json:"thetaBS"`
+		Lever      string `json:"lever"`
+		MarkVol    string `json:"markVol"`
+		BidVol     string `json:"bidVol"`
+		AskVol     string `json:"askVol"`
+		RealVol    string `json:"realVol"`
+		VolLv      string `json:"volLv"`
+		FwdPx      string `json:"fwdPx"`
+		Ts         string `json:"ts"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*o = OptionSummary{
+		InstType:   raw.InstType,
+		InstId:     raw.InstId,
+		Uly:        raw.Uly,
+		InstFamily: raw.InstFamily,
+		Delta:      p.float("delta", raw.Delta),
+		Gamma:      p.float("gamma", raw.Gamma),
+		Vega:       p.float("vega", raw.Vega),
+		Theta:      p.float("theta", raw.Theta),
+		DeltaBS:    p.float("deltaBS", raw.DeltaBS),
+		GammaBS:    p.float("gammaBS", raw.GammaBS),
+		VegaBS:     p.float("vegaBS", raw.VegaBS),
+		ThetaBS:    p.float("thetaBS", raw.ThetaBS),
+		Lever:      p.float("lever", raw.Lever),
+		MarkVol:    p.float("markVol", raw.MarkVol),
+		BidVol:     p.float("bidVol", raw.BidVol),
+		AskVol:     p.float("askVol", raw.AskVol),
+		RealVol:    p.float("realVol", raw.RealVol),
+		VolLv:      p.float("volLv", raw.VolLv),
+		FwdPx:      p.float("fwdPx", raw.FwdPx),
+		Ts:         p.time("ts", raw.Ts),
+	}
+	return p.err
+}
+
+// ConvertType define the direction of a contract/coin conversion
+type ConvertType string
+
+const (
+	ConvertTypeCoinToContract ConvertType = "1"
+	ConvertTypeContractToCoin ConvertType = "2"
+)
+
+// ConvertUnitType define the unit of the coin side of a conversion
+type ConvertUnitType string
+
+const (
+	ConvertUnitCoin ConvertUnitType = "coin"
+	ConvertUnitUsds ConvertUnitType = "usds"
+)
+
+// ConvertContractCoinService convert between contracts and coins, see ContractConverter for a local conversion
+type ConvertContractCoinService struct {
+	c           *Client
+	convertType *ConvertType
+	instId      string
+	sz          string
+	px          *string
+	unit        *ConvertUnitType
+}
+
+// Set conversion type, defaults to coin to contract
+func (s *ConvertContractCoinService) Type(convertType ConvertType) *ConvertContractCoinService {
+	s.convertType = &convertType
+	return s
+}
+
+// Set instrument id, SWAP, FUTURES or OPTION
+func (s *ConvertContractCoinService) InstrumentId(instId string) *ConvertContractCoinService {
+	s.instId = instId
+	return s
+}
+
+// Set quantity to convert, in coins or contracts depending on the type
+func (s *ConvertContractCoinService) Size(sz string) *ConvertContractCoinService {
+	s.sz = sz
+	return s
+}
+
+// Set price, required for inverse contracts and for usds conversions of linear contracts
+func (s *ConvertContractCoinService) Price(px string) *ConvertContractCoinService {
+	s.px = &px
+	return s
+}
+
+// Set unit of the coin side, defaults to coin
+func (s *ConvertContractCoinService) Unit(unit ConvertUnitType) *ConvertContractCoinService {
+	s.unit = &unit
+	return s
+}
+
+// Do send request
+func (s *ConvertContractCoinService) Do(ctx context.Context, opts ...RequestOption) (res *ConvertContractCoinServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/public/convert-contract-coin",
+	}
+
+	r.setParam("instId", s.instId)
+	r.setParam("sz", s.sz)
+
+	if s.convertType != nil {
+		r.setParam("type", string(*s.convertType))
+	}
+	if s.px != nil {
+		r.setParam("px", *s.px)
+	}
+	if s.unit != nil {
+		r.setParam("unit", string(*s.unit))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(ConvertContractCoinServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to ConvertContractCoinService
+type ConvertContractCoinServiceResponse struct {
+	Code string                 `json:"code"`
+	Msg  string                 `json:"msg"`
+	Data []*ContractCoinConvert `json:"data"`
+}
+
+// ContractCoinConvert is the converted quantity Sz, in contracts or in Unit depending on Type
+type ContractCoinConvert struct {
+	Type   ConvertType
+	InstId string
+	Px     float64
+	Sz     float64
+	Unit   ConvertUnitType
+}
+
+// UnmarshalJSON parses the numeric fields
+func (c *ContractCoinConvert) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type   ConvertType     `json:"type"`
+		InstId string          `json:"instId"`
+		Px     string          `json:"px"`
+		Sz     string          `json:"sz"`
+		Unit   ConvertUnitType `json:"unit"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*c = ContractCoinConvert{
+		Type:   raw.Type,
+		InstId: raw.InstId,
+		Px:     p.float("px", raw.Px),
+		Sz:     p.float("sz", raw.Sz),
+		Unit:   raw.Unit,
+	}
+	return p.err
+}
+
+// fieldParser parses the string encoded numbers and millisecond timestamps of a response,
+// keeping the first error. Empty strings are parsed as zero values.
+type fieldParser struct {
+	err error
+}
+
+func (p *fieldParser) float(name, value string) float64 {
+	if value == "" || p.err != nil {
+		return 0
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		p.err = fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+	return f
+}
+
+// optionalFloat returns nil for an empty value
+func (p *fieldParser) optionalFloat(name, value string) *float64 {
+	if value == "" {
+		return nil
+	}
+	f := p.float(name, value)
+	return &f
+}
+
+func (p *fieldParser) time(name, value string) time.Time {
+	if value == "" || p.err != nil {
+		return time.Time{}
+	}
+	ms, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		p.err = fmt.Errorf("invalid %s %q: %w", name, value, err)
+		return time.Time{}
+	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
+
+func (p *fieldParser) int(name, value string) int {
+	if value == "" || p.err != nil {
+		return 0
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		p.err = fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+	return i
+}
@@ -0,0 +1,60 @@
+package okex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFundingRateUnmarshal(t *testing.T) {
+	assert := assert.New(t)
+
+	var res GetFundingRateServiceResponse
+	err := json.Unmarshal([]byte(`{"code":"0","msg":"","data":[{"instType":"SWAP","instId":"BTC-USD-SWAP",
+		"fundingRate":"0.00011","nextFundingRate":"","fundingTime":"1657008000000","nextFundingTime":"1657036800000"},
+		{"instType":"SWAP","instId":"ETH-USD-SWAP","fundingRate":"0.0001","nextFundingRate":"0",
+		"fundingTime":"1657008000000","nextFundingTime":"1657036800000"}]}`), &res)
+	assert.NoError(err)
+	assert.Len(res.Data, 2)
+	f := res.Data[0]
+	assert.Equal("BTC-USD-SWAP", f.InstId)
+	assert.Equal(0.00011, f.FundingRate)
+	assert.Nil(f.NextFundingRate)
+	assert.True(f.FundingTime.Equal(time.Unix(1657008000, 0)))
+	assert.True(f.NextFundingTime.Equal(time.Unix(1657036800, 0)))
+	// a predicted rate of 0 is told apart from a missing one
+	if assert.NotNil(res.Data[1].NextFundingRate) {
+		assert.Equal(0.0, *res.Data[1].NextFundingRate)
+	}
+
+	var m MarkPrice
+	err = json.Unmarshal([]byte(`{"instType":"SWAP","instId":"BTC-USD-SWAP","markPx":"x","ts":"1"}`), &m)
+	assert.EqualError(err, `invalid markPx "x": strconv.ParseFloat: parsing "x": invalid syntax`)
+}
+
+func TestOptionSummary(t *testing.T) {
+	assert := assert.New(t)
+
+	var o OptionSummary
+	err := json.Unmarshal([]byte(`{"instType":"OPTION","instId":"BTC-USD-220708-20000-C","uly":"BTC-USD",
+		"instFamily":"BTC-USD","delta":"0.9","gamma":"0.1","vega":"0.01","theta":"-0.02","deltaBS":"0.8",
+		"gammaBS":"0.1","vegaBS":"10","thetaBS":"-20","lever":"5.5","markVol":"0.6","bidVol":"0.5",
+		"askVol":"0.7","realVol":"","volLv":"0.6","fwdPx":"20000","ts":"1657008000000"}`), &o)
+	assert.NoError(err)
+	assert.Equal("BTC-USD", o.Uly)
+	assert.Equal("BTC-USD", o.InstFamily)
+	assert.Equal(0.9, o.Delta)
+
+	// the request is not sent without an underlying or an instrument family
+	c := NewClient("", "", "")
+	c.do = func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request %s", req.URL)
+		return nil, nil
+	}
+	_, err = c.NewGetOptionSummaryService().Do(context.Background())
+	assert.Error(err)
+}
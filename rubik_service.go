@@ -0,0 +1,598 @@
+package okex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/tbtc-bot/go-okex/impl"
+)
+
+// GetSupportCoinService get the currencies supported by the trading statistics endpoints
+type GetSupportCoinService struct {
+	c *Client
+}
+
+// Do send request
+func (s *GetSupportCoinService) Do(ctx context.Context, opts ...RequestOption) (res *GetSupportCoinServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/rubik/stat/trading-data/support-coin",
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetSupportCoinServiceResponse)
+	err = unmarshalObjectResponse(data, &res.Code, &res.Msg, &res.Data)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetSupportCoinService, data is a single object
+type GetSupportCoinServiceResponse struct {
+	Code string       `json:"code"`
+	Msg  string       `json:"msg"`
+	Data *SupportCoin `json:"data"`
+}
+
+type SupportCoin struct {
+	Contract []string `json:"contract"`
+	Option   []string `json:"option"`
+	Spot     []string `json:"spot"`
+}
+
+// TakerVolumeInstType define the market of the taker volume statistics
+type TakerVolumeInstType string
+
+const (
+	TakerVolumeInstTypeSpot      TakerVolumeInstType = "SPOT"
+	TakerVolumeInstTypeContracts TakerVolumeInstType = "CONTRACTS"
+)
+
+// GetTakerVolumeService get the taker buy and sell volume of a currency
+type GetTakerVolumeService struct {
+	c        *Client
+	ccy      string
+	instType TakerVolumeInstType
+	begin    *string
+	end      *string
+	period   *Period
+}
+
+// Set currency
+func (s *GetTakerVolumeService) Currency(ccy string) *GetTakerVolumeService {
+	s.ccy = ccy
+	return s
+}
+
+// Set instrument type
+func (s *GetTakerVolumeService) InstrumentType(instType TakerVolumeInstType) *GetTakerVolumeService {
+	s.instType = instType
+	return s
+}
+
+// Set begin, ts in ms
+func (s *GetTakerVolumeService) Begin(begin string) *GetTakerVolumeService {
+	s.begin = &begin
+	return s
+}
+
+// Set end, ts in ms
+func (s *GetTakerVolumeService) End(end string) *GetTakerVolumeService {
+	s.end = &end
+	return s
+}
+
+// Set period, PERIOD_5MIN, PERIOD_1HOUR or PERIOD_1DAY, defaults to 5m
+func (s *GetTakerVolumeService) Period(period Period) *GetTakerVolumeService {
+	s.period = &period
+	return s
+}
+
+// Do send request
+func (s *GetTakerVolumeService) Do(ctx context.Context, opts ...RequestOption) (res *GetTakerVolumeServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/rubik/stat/taker-volume",
+	}
+
+	r.setParam("ccy", s.ccy)
+	r.setParam("instType", string(s.instType))
+
+	if s.begin != nil {
+		r.setParam("begin", *s.begin)
+	}
+	if s.end != nil {
+		r.setParam("end", *s.end)
+	}
+	if s.period != nil {
+		r.setParam("period", string(*s.period))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetTakerVolumeServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetTakerVolumeService
+type GetTakerVolumeServiceResponse struct {
+	Code string         `json:"code"`
+	Msg  string         `json:"msg"`
+	Data []*TakerVolume `json:"data"`
+}
+
+// TakerVolume is the taker volume of a period, in the currency
+type TakerVolume struct {
+	Ts      time.Time
+	SellVol float64
+	BuyVol  float64
+}
+
+// UnmarshalJSON decodes a [ts, sellVol, buyVol] row
+func (v *TakerVolume) UnmarshalJSON(data []byte) error {
+	row, err := statRow(data, 3)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*v = TakerVolume{
+		Ts:      p.time("ts", row[0]),
+		SellVol: p.float("sellVol", row[1]),
+		BuyVol:  p.float("buyVol", row[2]),
+	}
+	return p.err
+}
+
+// GetTradingRatioService get a ratio time series of a currency.
+// The ratio depends on the endpoint, see the NewGetXXXRatioService constructors.
+type GetTradingRatioService struct {
+	c        *Client
+	endpoint string
+	ccy      string
+	begin    *string
+	end      *string
+	period   *Period
+}
+
+// Set currency
+func (s *GetTradingRatioService) Currency(ccy string) *GetTradingRatioService {
+	s.ccy = ccy
+	return s
+}
+
+// Set begin, ts in ms
+func (s *GetTradingRatioService) Begin(begin string) *GetTradingRatioService {
+	s.begin = &begin
+	return s
+}
+
+// Set end, ts in ms
+func (s *GetTradingRatioService) End(end string) *GetTradingRatioService {
+	s.end = &end
+	return s
+}
+
+// Set period, PERIOD_5MIN, PERIOD_1HOUR or PERIOD_1DAY, defaults to 5m
+func (s *GetTradingRatioService) Period(period Period) *GetTradingRatioService {
+	s.period = &period
+	return s
+}
+
+// Do send request
+func (s *GetTradingRatioService) Do(ctx context.Context, opts ...RequestOption) (res *GetTradingRatioServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: s.endpoint,
+	}
+
+	r.setParam("ccy", s.ccy)
+
+	if s.begin != nil {
+		r.setParam("begin", *s.begin)
+	}
+	if s.end != nil {
+		r.setParam("end", *s.end)
+	}
+	if s.period != nil {
+		r.setParam("period", string(*s.period))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetTradingRatioServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetTradingRatioService
+type GetTradingRatioServiceResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data []*TradingRatio `json:"data"`
+}
+
+type TradingRatio struct {
+	Ts    time.Time
+	Ratio float64
+}
+
+// UnmarshalJSON decodes a [ts, ratio] row
+func (t *TradingRatio) UnmarshalJSON(data []byte) error {
+	row, err := statRow(data, 2)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*t = TradingRatio{
+		Ts:    p.time("ts", row[0]),
+		Ratio: p.float("ratio", row[1]),
+	}
+	return p.err
+}
+
+// GetOpenInterestVolumeService get the open interest and trading volume of the contracts or options of a currency.
+// See the NewGetXXXOpenInterestVolumeService constructors.
+type GetOpenInterestVolumeService struct {
+	c        *Client
+	endpoint string
+	ccy      string
+	begin    *string
+	end      *string
+	period   *Period
+}
+
+// Set currency
+func (s *GetOpenInterestVolumeService) Currency(ccy string) *GetOpenInterestVolumeService {
+	s.ccy = ccy
+	return s
+}
+
+// Set begin, ts in ms, contracts only
+func (s *GetOpenInterestVolumeService) Begin(begin string) *GetOpenInterestVolumeService {
+	s.begin = &begin
+	return s
+}
+
+// Set end, ts in ms, contracts only
+func (s *GetOpenInterestVolumeService) End(end string) *GetOpenInterestVolumeService {
+	s.end = &end
+	return s
+}
+
+// Set period, PERIOD_5MIN, PERIOD_1HOUR or PERIOD_1DAY for contracts,
+// PERIOD_8HOUR, PERIOD_1DAY or PERIOD_1WEEK for options
+func (s *GetOpenInterestVolumeService) Period(period Period) *GetOpenInterestVolumeService {
+	s.period = &period
+	return s
+}
+
+// Do send request
+func (s *GetOpenInterestVolumeService) Do(ctx context.Context, opts ...RequestOption) (res *GetOpenInterestVolumeServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: s.endpoint,
+	}
+
+	r.setParam("ccy", s.ccy)
+
+	if s.begin != nil {
+		r.setParam("begin", *s.begin)
+	}
+	if s.end != nil {
+		r.setParam("end", *s.end)
+	}
+	if s.period != nil {
+		r.setParam("period", string(*s.period))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetOpenInterestVolumeServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetOpenInterestVolumeService
+type GetOpenInterestVolumeServiceResponse struct {
+	Code string                `json:"code"`
+	Msg  string                `json:"msg"`
+	Data []*OpenInterestVolume `json:"data"`
+}
+
+// OpenInterestVolume is the open interest and trading volume of a period, in USD for contracts
+// and in the currency for options
+type OpenInterestVolume struct {
+	Ts  time.Time
+	Oi  float64
+	Vol float64
+}
+
+// UnmarshalJSON decodes a [ts, oi, vol] row
+func (v *OpenInterestVolume) UnmarshalJSON(data []byte) error {
+	row, err := statRow(data, 3)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*v = OpenInterestVolume{
+		Ts:  p.time("ts", row[0]),
+		Oi:  p.float("oi", row[1]),
+		Vol: p.float("vol", row[2]),
+	}
+	return p.err
+}
+
+// GetPutCallRatioService get the put/call ratio of the open interest and trading volume of the options of a currency
+type GetPutCallRatioService struct {
+	c      *Client
+	ccy    string
+	period *Period
+}
+
+// Set currency
+func (s *GetPutCallRatioService) Currency(ccy string) *GetPutCallRatioService {
+	s.ccy = ccy
+	return s
+}
+
+// Set period, PERIOD_8HOUR or PERIOD_1DAY, defaults to 8H
+func (s *GetPutCallRatioService) Period(period Period) *GetPutCallRatioService {
+	s.period = &period
+	return s
+}
+
+// Do send request
+func (s *GetPutCallRatioService) Do(ctx context.Context, opts ...RequestOption) (res *GetPutCallRatioServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/rubik/stat/option/open-interest-volume-ratio",
+	}
+
+	r.setParam("ccy", s.ccy)
+
+	if s.period != nil {
+		r.setParam("period", string(*s.period))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetPutCallRatioServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetPutCallRatioService
+type GetPutCallRatioServiceResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data []*PutCallRatio `json:"data"`
+}
+
+type PutCallRatio struct {
+	Ts       time.Time
+	OiRatio  float64
+	VolRatio float64
+}
+
+// UnmarshalJSON decodes a [ts, oiRatio, volRatio] row
+func (r *PutCallRatio) UnmarshalJSON(data []byte) error {
+	row, err := statRow(data, 3)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*r = PutCallRatio{
+		Ts:       p.time("ts", row[0]),
+		OiRatio:  p.float("oiRatio", row[1]),
+		VolRatio: p.float("volRatio", row[2]),
+	}
+	return p.err
+}
+
+// GetOpenInterestVolumeExpiryService get the call and put open interest and volume of the options of a currency by expiry
+type GetOpenInterestVolumeExpiryService struct {
+	c      *Client
+	ccy    string
+	period *Period
+}
+
+// Set currency
+func (s *GetOpenInterestVolumeExpiryService) Currency(ccy string) *GetOpenInterestVolumeExpiryService {
+	s.ccy = ccy
+	return s
+}
+
+// Set period, PERIOD_8HOUR or PERIOD_1DAY, defaults to 8H
+func (s *GetOpenInterestVolumeExpiryService) Period(period Period) *GetOpenInterestVolumeExpiryService {
+	s.period = &period
+	return s
+}
+
+// Do send request
+func (s *GetOpenInterestVolumeExpiryService) Do(ctx context.Context, opts ...RequestOption) (res *GetOpenInterestVolumeExpiryServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/rubik/stat/option/open-interest-volume-expiry",
+	}
+
+	r.setParam("ccy", s.ccy)
+
+	if s.period != nil {
+		r.setParam("period", string(*s.period))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetOpenInterestVolumeExpiryServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetOpenInterestVolumeExpiryService
+type GetOpenInterestVolumeExpiryServiceResponse struct {
+	Code string                      `json:"code"`
+	Msg  string                      `json:"msg"`
+	Data []*OpenInterestVolumeExpiry `json:"data"`
+}
+
+// OpenInterestVolumeExpiry is the option open interest and volume of an expiry, in the currency
+type OpenInterestVolumeExpiry struct {
+	Ts time.Time
+	// ExpTime is the expiry date, format YYYYMMdd
+	ExpTime string
+	CallOI  float64
+	PutOI   float64
+	CallVol float64
+	PutVol  float64
+}
+
+// UnmarshalJSON decodes a [ts, expTime, callOI, putOI, callVol, putVol] row
+func (v *OpenInterestVolumeExpiry) UnmarshalJSON(data []byte) error {
+	row, err := statRow(data, 6)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*v = OpenInterestVolumeExpiry{
+		Ts:      p.time("ts", row[0]),
+		ExpTime: row[1],
+		CallOI:  p.float("callOI", row[2]),
+		PutOI:   p.float("putOI", row[3]),
+		CallVol: p.float("callVol", row[4]),
+		PutVol:  p.float("putVol", row[5]),
+	}
+	return p.err
+}
+
+// GetOpenInterestVolumeStrikeService get the call and put open interest and volume of the options of an expiry by strike
+type GetOpenInterestVolumeStrikeService struct {
+	c       *Client
+	ccy     string
+	expTime string
+	period  *Period
+}
+
+// Set currency
+func (s *GetOpenInterestVolumeStrikeService) Currency(ccy string) *GetOpenInterestVolumeStrikeService {
+	s.ccy = ccy
+	return s
+}
+
+// Set expiry date, format YYYYMMdd, e.g. 20210623
+func (s *GetOpenInterestVolumeStrikeService) ExpiryTime(expTime string) *GetOpenInterestVolumeStrikeService {
+	s.expTime = expTime
+	return s
+}
+
+// Set period, PERIOD_8HOUR or PERIOD_1DAY, defaults to 8H
+func (s *GetOpenInterestVolumeStrikeService) Period(period Period) *GetOpenInterestVolumeStrikeService {
+	s.period = &period
+	return s
+}
+
+// Do send request
+func (s *GetOpenInterestVolumeStrikeService) Do(ctx context.Context, opts ...RequestOption) (res *GetOpenInterestVolumeStrikeServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/rubik/stat/option/open-interest-volume-strike",
+	}
+
+	r.setParam("ccy", s.ccy)
+	r.setParam("expTime", s.expTime)
+
+	if s.period != nil {
+		r.setParam("period", string(*s.period))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetOpenInterestVolumeStrikeServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetOpenInterestVolumeStrikeService
+type GetOpenInterestVolumeStrikeServiceResponse struct {
+	Code string                      `json:"code"`
+	Msg  string                      `json:"msg"`
+	Data []*OpenInterestVolumeStrike `json:"data"`
+}
+
+// OpenInterestVolumeStrike is the option open interest and volume of a strike price, in the currency
+type OpenInterestVolumeStrike struct {
+	Ts      time.Time
+	Strike  float64
+	CallOI  float64
+	PutOI   float64
+	CallVol float64
+	PutVol  float64
+}
+
+// UnmarshalJSON decodes a [ts, strike, callOI, putOI, callVol, putVol] row
+func (v *OpenInterestVolumeStrike) UnmarshalJSON(data []byte) error {
+	row, err := statRow(data, 6)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*v = OpenInterestVolumeStrike{
+		Ts:      p.time("ts", row[0]),
+		Strike:  p.float("strike", row[1]),
+		CallOI:  p.float("callOI", row[2]),
+		PutOI:   p.float("putOI", row[3]),
+		CallVol: p.float("callVol", row[4]),
+		PutVol:  p.float("putVol", row[5]),
+	}
+	return p.err
+}
+
+// statRow decodes a statistics row of at least n values
+func statRow(data []byte, n int) ([]string, error) {
+	var row []string
+	err := json.Unmarshal(data, &row)
+	if err != nil {
+		return nil, err
+	}
+	if len(row) < n {
+		return nil, fmt.Errorf("invalid statistics row %s", data)
+	}
+	return row, nil
+}
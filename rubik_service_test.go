@@ -0,0 +1,64 @@
+package okex
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatRowsUnmarshal(t *testing.T) {
+	assert := assert.New(t)
+	ts := time.Unix(1630425600, 0)
+	tests := []struct {
+		name  string
+		row   string
+		value interface{}
+		want  interface{}
+	}{
+		{
+			name:  "taker volume",
+			row:   `["1630425600000","7596.2651","7149.4855"]`,
+			value: new(TakerVolume),
+			want:  &TakerVolume{Ts: ts, SellVol: 7596.2651, BuyVol: 7149.4855},
+		},
+		{
+			name:  "ratio",
+			row:   `["1630425600000","0.9837"]`,
+			value: new(TradingRatio),
+			want:  &TradingRatio{Ts: ts, Ratio: 0.9837},
+		},
+		{
+			name:  "open interest and volume",
+			row:   `["1630425600000","2940742000","3620440000"]`,
+			value: new(OpenInterestVolume),
+			want:  &OpenInterestVolume{Ts: ts, Oi: 2940742000, Vol: 3620440000},
+		},
+		{
+			name:  "put/call ratio",
+			row:   `["1630425600000","1.1","0.85"]`,
+			value: new(PutCallRatio),
+			want:  &PutCallRatio{Ts: ts, OiRatio: 1.1, VolRatio: 0.85},
+		},
+		{
+			name:  "open interest and volume by expiry",
+			row:   `["1630425600000","20210903","220","120","20","10"]`,
+			value: new(OpenInterestVolumeExpiry),
+			want:  &OpenInterestVolumeExpiry{Ts: ts, ExpTime: "20210903", CallOI: 220, PutOI: 120, CallVol: 20, PutVol: 10},
+		},
+		{
+			name:  "open interest and volume by strike",
+			row:   `["1630425600000","48000","220","120","20","10"]`,
+			value: new(OpenInterestVolumeStrike),
+			want:  &OpenInterestVolumeStrike{Ts: ts, Strike: 48000, CallOI: 220, PutOI: 120, CallVol: 20, PutVol: 10},
+		},
+	}
+	for _, tt := range tests {
+		assert.NoError(json.Unmarshal([]byte(tt.row), tt.value), tt.name)
+		assert.Equal(tt.want, tt.value, tt.name)
+	}
+
+	err := json.Unmarshal([]byte(`["1630425600000","48000"]`), new(OpenInterestVolumeStrike))
+	assert.EqualError(err, `invalid statistics row ["1630425600000","48000"]`)
+}
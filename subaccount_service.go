@@ -0,0 +1,617 @@
+package okex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+)
+
+// GetSubAccountListService list the sub-accounts of the master account
+type GetSubAccountListService struct {
+	c       *Client
+	enable  *bool
+	subAcct *string
+	after   *string
+	before  *string
+	limit   *string
+}
+
+// Set Enable, true for normal sub-accounts and false for frozen ones
+func (s *GetSubAccountListService) Enable(enable bool) *GetSubAccountListService {
+	s.enable = &enable
+	return s
+}
+
+// Set SubAccount
+func (s *GetSubAccountListService) SubAccount(subAcct string) *GetSubAccountListService {
+	s.subAcct = &subAcct
+	return s
+}
+
+// Set after, pagination of data to return records earlier than the requested creation ts in ms
+func (s *GetSubAccountListService) After(after string) *GetSubAccountListService {
+	s.after = &after
+	return s
+}
+
+// Set before, pagination of data to return records newer than the requested creation ts in ms
+func (s *GetSubAccountListService) Before(before string) *GetSubAccountListService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 100
+func (s *GetSubAccountListService) Limit(limit string) *GetSubAccountListService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetSubAccountListService) Do(ctx context.Context, opts ...RequestOption) (res *GetSubAccountListServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/users/subaccount/list",
+		secType:  secTypeSigned,
+	}
+
+	if s.enable != nil {
+		r.setParam("enable", strconv.FormatBool(*s.enable))
+	}
+	if s.subAcct != nil {
+		r.setParam("subAcct", *s.subAcct)
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetSubAccountListServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetSubAccountListServiceResponse struct {
+	Code string        `json:"code"`
+	Data []*SubAccount `json:"data"`
+	Msg  string        `json:"msg"`
+}
+
+type SubAccount struct {
+	Type        string `json:"type"`
+	Enable      bool   `json:"enable"`
+	SubAcct     string `json:"subAcct"`
+	Uid         string `json:"uid"`
+	Label       string `json:"label"`
+	Mobile      string `json:"mobile"`
+	GAuth       bool   `json:"gAuth"`
+	CanTransOut bool   `json:"canTransOut"`
+	Ts          string `json:"ts"`
+}
+
+// GetSubAccountBalanceService get the trading account balance of a sub-account
+type GetSubAccountBalanceService struct {
+	c       *Client
+	subAcct string
+}
+
+// Set SubAccount
+func (s *GetSubAccountBalanceService) SubAccount(subAcct string) *GetSubAccountBalanceService {
+	s.subAcct = subAcct
+	return s
+}
+
+// Do send request
+func (s *GetSubAccountBalanceService) Do(ctx context.Context, opts ...RequestOption) (res *GetBalanceServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/account/subaccount/balances",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("subAcct", s.subAcct)
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetBalanceServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSubAccountFundingBalanceService get the funding account balance of a sub-account
+type GetSubAccountFundingBalanceService struct {
+	c       *Client
+	subAcct string
+	ccy     *string
+}
+
+// Set SubAccount
+func (s *GetSubAccountFundingBalanceService) SubAccount(subAcct string) *GetSubAccountFundingBalanceService {
+	s.subAcct = subAcct
+	return s
+}
+
+// Set Currency, up to 20 comma separated currencies
+func (s *GetSubAccountFundingBalanceService) Currency(ccy string) *GetSubAccountFundingBalanceService {
+	s.ccy = &ccy
+	return s
+}
+
+// Do send request
+func (s *GetSubAccountFundingBalanceService) Do(ctx context.Context, opts ...RequestOption) (res *GetFundingBalanceServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/subaccount/balances",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("subAcct", s.subAcct)
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetFundingBalanceServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubAccountTransferService transfer funds between two sub-accounts with the master account API key.
+// Transfers between the master account and a sub-account are made with FundTransferService and
+// TransferTypeMasterToSub or TransferTypeSubToMasterByMaster.
+type SubAccountTransferService struct {
+	c              *Client
+	ccy            string
+	amt            string
+	from           AccountType
+	to             AccountType
+	fromSubAccount string
+	toSubAccount   string
+	loanTrans      *bool
+}
+
+// Set Currency
+func (s *SubAccountTransferService) Currency(ccy string) *SubAccountTransferService {
+	s.ccy = ccy
+	return s
+}
+
+// Set Amount
+func (s *SubAccountTransferService) Amount(amt string) *SubAccountTransferService {
+	s.amt = amt
+	return s
+}
+
+// Set From, the account type of the sending sub-account
+func (s *SubAccountTransferService) From(from AccountType) *SubAccountTransferService {
+	s.from = from
+	return s
+}
+
+// Set To, the account type of the receiving sub-account
+func (s *SubAccountTransferService) To(to AccountType) *SubAccountTransferService {
+	s.to = to
+	return s
+}
+
+// Set From SubAccount
+func (s *SubAccountTransferService) FromSubAccount(fromSubAccount string) *SubAccountTransferService {
+	s.fromSubAccount = fromSubAccount
+	return s
+}
+
+// Set To SubAccount
+func (s *SubAccountTransferService) ToSubAccount(toSubAccount string) *SubAccountTransferService {
+	s.toSubAccount = toSubAccount
+	return s
+}
+
+// Set Loan Transfer, whether or not borrowed coins can be transferred out
+func (s *SubAccountTransferService) LoanTransfer(loanTrans bool) *SubAccountTransferService {
+	s.loanTrans = &loanTrans
+	return s
+}
+
+// Do send request
+func (s *SubAccountTransferService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountTransferServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/asset/subaccount/transfer",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("ccy", s.ccy)
+	r.setBodyParam("amt", s.amt)
+	r.setBodyParam("from", string(s.from))
+	r.setBodyParam("to", string(s.to))
+	r.setBodyParam("fromSubAccount", s.fromSubAccount)
+	r.setBodyParam("toSubAccount", s.toSubAccount)
+
+	if s.loanTrans != nil {
+		r.setBodyParam("loanTrans", strconv.FormatBool(*s.loanTrans))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountTransferServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type SubAccountTransferServiceResponse struct {
+	Code string                `json:"code"`
+	Data []*FundTransferDetail `json:"data"`
+	Msg  string                `json:"msg"`
+}
+
+// GetSubAccountBillsService get the transfer history between the master account and its sub-accounts
+type GetSubAccountBillsService struct {
+	c       *Client
+	ccy     *string
+	typ     *string
+	subAcct *string
+	after   *string
+	before  *string
+	limit   *string
+}
+
+// Set Currency
+func (s *GetSubAccountBillsService) Currency(ccy string) *GetSubAccountBillsService {
+	s.ccy = &ccy
+	return s
+}
+
+// Set Type, 0 for master to sub-account and 1 for sub-account to master
+func (s *GetSubAccountBillsService) Type(typ string) *GetSubAccountBillsService {
+	s.typ = &typ
+	return s
+}
+
+// Set SubAccount
+func (s *GetSubAccountBillsService) SubAccount(subAcct string) *GetSubAccountBillsService {
+	s.subAcct = &subAcct
+	return s
+}
+
+// Set after, pagination of data to return records earlier than the requested ts in ms
+func (s *GetSubAccountBillsService) After(after string) *GetSubAccountBillsService {
+	s.after = &after
+	return s
+}
+
+// Set before, pagination of data to return records newer than the requested ts in ms
+func (s *GetSubAccountBillsService) Before(before string) *GetSubAccountBillsService {
+	s.before = &before
+	return s
+}
+
+// Set limit, max 100
+func (s *GetSubAccountBillsService) Limit(limit string) *GetSubAccountBillsService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetSubAccountBillsService) Do(ctx context.Context, opts ...RequestOption) (res *GetSubAccountBillsServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/asset/subaccount/bills",
+		secType:  secTypeSigned,
+	}
+
+	if s.ccy != nil {
+		r.setParam("ccy", *s.ccy)
+	}
+	if s.typ != nil {
+		r.setParam("type", *s.typ)
+	}
+	if s.subAcct != nil {
+		r.setParam("subAcct", *s.subAcct)
+	}
+	if s.after != nil {
+		r.setParam("after", *s.after)
+	}
+	if s.before != nil {
+		r.setParam("before", *s.before)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetSubAccountBillsServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type GetSubAccountBillsServiceResponse struct {
+	Code string            `json:"code"`
+	Data []*SubAccountBill `json:"data"`
+	Msg  string            `json:"msg"`
+}
+
+type SubAccountBill struct {
+	BillId  string `json:"billId"`
+	Type    string `json:"type"`
+	Ccy     string `json:"ccy"`
+	Amt     string `json:"amt"`
+	SubAcct string `json:"subAcct"`
+	Ts      string `json:"ts"`
+}
+
+// CreateSubAccountApiKeyService create an API key for a sub-account
+type CreateSubAccountApiKeyService struct {
+	c          *Client
+	subAcct    string
+	label      string
+	passphrase string
+	perm       *string
+	ip         *string
+}
+
+// Set SubAccount
+func (s *CreateSubAccountApiKeyService) SubAccount(subAcct string) *CreateSubAccountApiKeyService {
+	s.subAcct = subAcct
+	return s
+}
+
+// Set Label
+func (s *CreateSubAccountApiKeyService) Label(label string) *CreateSubAccountApiKeyService {
+	s.label = label
+	return s
+}
+
+// Set Passphrase
+func (s *CreateSubAccountApiKeyService) Passphrase(passphrase string) *CreateSubAccountApiKeyService {
+	s.passphrase = passphrase
+	return s
+}
+
+// Set Permissions, comma separated 'read_only' and 'trade', defaults to read_only
+func (s *CreateSubAccountApiKeyService) Permissions(perm string) *CreateSubAccountApiKeyService {
+	s.perm = &perm
+	return s
+}
+
+// Set IP, up to 20 comma separated IPv4 addresses bound to the key
+func (s *CreateSubAccountApiKeyService) IP(ip string) *CreateSubAccountApiKeyService {
+	s.ip = &ip
+	return s
+}
+
+// Do send request
+func (s *CreateSubAccountApiKeyService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountApiKeyServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/users/subaccount/apikey",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("subAcct", s.subAcct)
+	r.setBodyParam("label", s.label)
+	r.setBodyParam("passphrase", s.passphrase)
+
+	if s.perm != nil {
+		r.setBodyParam("perm", *s.perm)
+	}
+	if s.ip != nil {
+		r.setBodyParam("ip", *s.ip)
+	}
+
+	return doSubAccountApiKey(ctx, s.c, r, opts...)
+}
+
+// GetSubAccountApiKeyService query the API keys of a sub-account
+type GetSubAccountApiKeyService struct {
+	c       *Client
+	subAcct string
+	apiKey  *string
+}
+
+// Set SubAccount
+func (s *GetSubAccountApiKeyService) SubAccount(subAcct string) *GetSubAccountApiKeyService {
+	s.subAcct = subAcct
+	return s
+}
+
+// Set API Key
+func (s *GetSubAccountApiKeyService) ApiKey(apiKey string) *GetSubAccountApiKeyService {
+	s.apiKey = &apiKey
+	return s
+}
+
+// Do send request
+func (s *GetSubAccountApiKeyService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountApiKeyServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/users/subaccount/apikey",
+		secType:  secTypeSigned,
+	}
+
+	r.setParam("subAcct", s.subAcct)
+
+	if s.apiKey != nil {
+		r.setParam("apiKey", *s.apiKey)
+	}
+
+	return doSubAccountApiKey(ctx, s.c, r, opts...)
+}
+
+// ModifySubAccountApiKeyService modify the label, permissions or IPs of a sub-account API key
+type ModifySubAccountApiKeyService struct {
+	c       *Client
+	subAcct string
+	apiKey  string
+	label   *string
+	perm    *string
+	ip      *string
+}
+
+// Set SubAccount
+func (s *ModifySubAccountApiKeyService) SubAccount(subAcct string) *ModifySubAccountApiKeyService {
+	s.subAcct = subAcct
+	return s
+}
+
+// Set API Key
+func (s *ModifySubAccountApiKeyService) ApiKey(apiKey string) *ModifySubAccountApiKeyService {
+	s.apiKey = apiKey
+	return s
+}
+
+// Set Label
+func (s *ModifySubAccountApiKeyService) Label(label string) *ModifySubAccountApiKeyService {
+	s.label = &label
+	return s
+}
+
+// Set Permissions, comma separated 'read_only' and 'trade'
+func (s *ModifySubAccountApiKeyService) Permissions(perm string) *ModifySubAccountApiKeyService {
+	s.perm = &perm
+	return s
+}
+
+// Set IP, up to 20 comma separated IPv4 addresses, an empty string unbinds all of them
+func (s *ModifySubAccountApiKeyService) IP(ip string) *ModifySubAccountApiKeyService {
+	s.ip = &ip
+	return s
+}
+
+// Do send request
+func (s *ModifySubAccountApiKeyService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountApiKeyServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/users/subaccount/modify-apikey",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("subAcct", s.subAcct)
+	r.setBodyParam("apiKey", s.apiKey)
+
+	if s.label != nil {
+		r.setBodyParam("label", *s.label)
+	}
+	if s.perm != nil {
+		r.setBodyParam("perm", *s.perm)
+	}
+	if s.ip != nil {
+		r.setBodyParam("ip", *s.ip)
+	}
+
+	return doSubAccountApiKey(ctx, s.c, r, opts...)
+}
+
+// DeleteSubAccountApiKeyService delete an API key of a sub-account
+type DeleteSubAccountApiKeyService struct {
+	c       *Client
+	subAcct string
+	apiKey  string
+}
+
+// Set SubAccount
+func (s *DeleteSubAccountApiKeyService) SubAccount(subAcct string) *DeleteSubAccountApiKeyService {
+	s.subAcct = subAcct
+	return s
+}
+
+// Set API Key
+func (s *DeleteSubAccountApiKeyService) ApiKey(apiKey string) *DeleteSubAccountApiKeyService {
+	s.apiKey = apiKey
+	return s
+}
+
+// Do send request
+func (s *DeleteSubAccountApiKeyService) Do(ctx context.Context, opts ...RequestOption) (res *SubAccountApiKeyServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v5/users/subaccount/delete-apikey",
+		secType:  secTypeSigned,
+	}
+
+	r.setBodyParam("subAcct", s.subAcct)
+	r.setBodyParam("apiKey", s.apiKey)
+
+	return doSubAccountApiKey(ctx, s.c, r, opts...)
+}
+
+func doSubAccountApiKey(ctx context.Context, c *Client, r *request, opts ...RequestOption) (res *SubAccountApiKeyServiceResponse, err error) {
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(SubAccountApiKeyServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to the sub-account API key services
+type SubAccountApiKeyServiceResponse struct {
+	Code string              `json:"code"`
+	Data []*SubAccountApiKey `json:"data"`
+	Msg  string              `json:"msg"`
+}
+
+// SubAccountApiKey is a sub-account API key, SecretKey and Passphrase are only returned on creation
+type SubAccountApiKey struct {
+	SubAcct    string `json:"subAcct"`
+	Label      string `json:"label"`
+	ApiKey     string `json:"apiKey"`
+	SecretKey  string `json:"secretKey"`
+	Passphrase string `json:"passphrase"`
+	Perm       string `json:"perm"`
+	Ip         string `json:"ip"`
+	Ts         string `json:"ts"`
+}
+
+// NewSubAccountClient returns a client authenticated with the credentials of a sub-account API key,
+// sharing the endpoint, HTTP client, logger and simulated mode of c. Withdrawals are disabled.
+func (c *Client) NewSubAccountClient(apiKey, secretKey, passPhrase string) *Client {
+	sub := NewClient(apiKey, secretKey, passPhrase)
+	sub.BaseURL = c.BaseURL
+	sub.UserAgent = c.UserAgent
+	sub.HTTPClient = c.HTTPClient
+	sub.Debug = c.Debug
+	sub.Simulated = c.Simulated
+	sub.Logger = c.Logger
+	sub.TimeOffset = c.TimeOffset
+	sub.do = c.do
+	return sub
+}
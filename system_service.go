@@ -0,0 +1,113 @@
+package okex
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+)
+
+// SystemStatusStateType define the state of a system maintenance
+type SystemStatusStateType string
+
+const (
+	SystemStatusStateScheduled SystemStatusStateType = "scheduled"
+	SystemStatusStateOngoing   SystemStatusStateType = "ongoing"
+	SystemStatusStatePreOpen   SystemStatusStateType = "pre_open"
+	SystemStatusStateCompleted SystemStatusStateType = "completed"
+	SystemStatusStateCanceled  SystemStatusStateType = "canceled"
+)
+
+// GetSystemStatusService get the scheduled and ongoing system maintenances
+type GetSystemStatusService struct {
+	c     *Client
+	state *SystemStatusStateType
+}
+
+// Set state, defaults to scheduled, ongoing and pre_open
+func (s *GetSystemStatusService) State(state SystemStatusStateType) *GetSystemStatusService {
+	s.state = &state
+	return s
+}
+
+// Do send request
+func (s *GetSystemStatusService) Do(ctx context.Context, opts ...RequestOption) (res *GetSystemStatusServiceResponse, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v5/system/status",
+	}
+
+	if s.state != nil {
+		r.setParam("state", string(*s.state))
+	}
+
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(GetSystemStatusServiceResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Response to GetSystemStatusService
+type GetSystemStatusServiceResponse struct {
+	Code string          `json:"code"`
+	Msg  string          `json:"msg"`
+	Data []*SystemStatus `json:"data"`
+}
+
+// SystemStatus is a maintenance of the services listed in ServiceType, planned from Begin to End.
+// PreOpenBegin is set when the trading resumes with a pre-open phase.
+type SystemStatus struct {
+	Title        string
+	State        SystemStatusStateType
+	Begin        time.Time
+	End          time.Time
+	PreOpenBegin time.Time
+	Href         string
+	ServiceType  string
+	System       string
+	ScheDesc     string
+	MaintType    string
+	Env          string
+}
+
+// UnmarshalJSON parses the time fields
+func (s *SystemStatus) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Title        string                `json:"title"`
+		State        SystemStatusStateType `json:"state"`
+		Begin        string                `json:"begin"`
+		End          string                `json:"end"`
+		PreOpenBegin string                `json:"preOpenBegin"`
+		Href         string                `json:"href"`
+		ServiceType  string                `json:"serviceType"`
+		System       string                `json:"system"`
+		ScheDesc     string                `json:"scheDesc"`
+		MaintType    string                `json:"maintType"`
+		Env          string                `json:"env"`
+	}
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	var p fieldParser
+	*s = SystemStatus{
+		Title:        raw.Title,
+		State:        raw.State,
+		Begin:        p.time("begin", raw.Begin),
+		End:          p.time("end", raw.End),
+		PreOpenBegin: p.time("preOpenBegin", raw.PreOpenBegin),
+		Href:         raw.Href,
+		ServiceType:  raw.ServiceType,
+		System:       raw.System,
+		ScheDesc:     raw.ScheDesc,
+		MaintType:    raw.MaintType,
+		Env:          raw.Env,
+	}
+	return p.err
+}
@@ -45,26 +45,27 @@ type WsInstrumentsEvent struct {
 }
 
 type WsInstrument struct {
-	InstType  string `json:"instType"`
-	InstId    string `json:"instId"`
-	Uly       string `json:"uly"`
-	Category  string `json:"category"`
-	BaseCcy   string `json:"baseCcy"`
-	QuoteCcy  string `json:"quoteCcy"`
-	SettleCcy string `json:"settleCcy"`
-	CtVal     string `json:"ctVal"`
-	CtMult    string `json:"ctMult"`
-	CtValCcy  string `json:"ctValCcy"`
-	OptType   string `json:"optType"`
-	Stk       string `json:"stk"`
-	ListTime  string `json:"listTime"`
-	ExpTime   string `json:"expTime"`
-	TickSz    string `json:"tickSz"`
-	LotSz     string `json:"lotSz"`
-	MinSz     string `json:"minSz"`
-	CtType    string `json:"ctType"`
-	Alias     string `json:"alias"`
-	State     string `json:"state"`
+	InstType   string `json:"instType"`
+	InstId     string `json:"instId"`
+	Uly        string `json:"uly"`
+	InstFamily string `json:"instFamily"`
+	Category   string `json:"category"`
+	BaseCcy    string `json:"baseCcy"`
+	QuoteCcy   string `json:"quoteCcy"`
+	SettleCcy  string `json:"settleCcy"`
+	CtVal      string `json:"ctVal"`
+	CtMult     string `json:"ctMult"`
+	CtValCcy   string `json:"ctValCcy"`
+	OptType    string `json:"optType"`
+	Stk        string `json:"stk"`
+	ListTime   string `json:"listTime"`
+	ExpTime    string `json:"expTime"`
+	TickSz     string `json:"tickSz"`
+	LotSz      string `json:"lotSz"`
+	MinSz      string `json:"minSz"`
+	CtType     string `json:"ctType"`
+	Alias      string `json:"alias"`
+	State      string `json:"state"`
 }
 
 // WsInstruments handle websocket instrument message
@@ -440,3 +441,125 @@ func wsBalancePositionServe(endpoint string, apiKey string, secretKey string, pa
 	}
 	return wsServe(cfg, wsHandler, errHandler)
 }
+
+// ACCOUNT GREEKS WEBSOCKET (PRIVATE)
+
+type WsAccountGreeksEvent struct {
+	Arg  map[string]string `json:"arg"`
+	Data []*GreeksDetail   `json:"data"`
+}
+
+// WsAccountGreeks handle websocket account greeks message
+type WsAccountGreeksHandler func(event *WsAccountGreeksEvent)
+
+func WsAccountGreeksServe(ccy string, apikey string, apisecret string, passphrase string, handler WsAccountGreeksHandler, errHandler ErrHandler, simulated bool) (doneC, stopC chan struct{}, err error) {
+	endpoint := getWsEndpoint(true, simulated) // get private endpoint
+	return wsAccountGreeksServe(endpoint, ccy, apikey, apisecret, passphrase, handler, errHandler)
+}
+
+// WsAccountGreeksServe serve websocket
+func wsAccountGreeksServe(endpoint string, ccy string, apiKey string, secretKey string, passPhrase string, handler WsAccountGreeksHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	arg := map[string]string{
+		"channel": "account-greeks",
+	}
+	if ccy != "" {
+		arg["ccy"] = ccy
+	}
+	var args []map[string]string
+	args = append(args, arg)
+	reqData := ReqData{Op: "subscribe",
+		Args: args,
+	}
+	cfg := newWsConfig(endpoint, reqData, apiKey, secretKey, passPhrase)
+	wsHandler := func(message []byte) {
+		event := new(WsAccountGreeksEvent)
+		err = json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// SYSTEM STATUS WEBSOCKET (PUBLIC)
+
+type WsStatusEvent struct {
+	Arg  map[string]string `json:"arg"`
+	Data []*SystemStatus   `json:"data"`
+}
+
+// WsStatus handle websocket system status message, pushed when a maintenance is scheduled or changes state
+type WsStatusHandler func(event *WsStatusEvent)
+
+func WsStatusServe(handler WsStatusHandler, errHandler ErrHandler, simulated bool) (doneC, stopC chan struct{}, err error) {
+	endpoint := getWsEndpoint(false, simulated)
+	return wsStatusServe(endpoint, handler, errHandler)
+}
+
+// WsStatusServe serve websocket
+func wsStatusServe(endpoint string, handler WsStatusHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	arg := map[string]string{
+		"channel": EVENT_STATUS.GetChannel(PERIOD_NONE),
+	}
+	var args []map[string]string
+	args = append(args, arg)
+	reqData := ReqData{Op: "subscribe",
+		Args: args,
+	}
+	cfg := newWsConfig(endpoint, reqData, "", "", "")
+	wsHandler := func(message []byte) {
+		event := new(WsStatusEvent)
+		err = json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}
+
+// OPTION SUMMARY WEBSOCKET (PUBLIC)
+
+type WsOptionSummaryEvent struct {
+	Arg  map[string]string `json:"arg"`
+	Data []*OptionSummary  `json:"data"`
+}
+
+// WsOptionSummary handle websocket option summary message
+type WsOptionSummaryHandler func(event *WsOptionSummaryEvent)
+
+// WsOptionSummaryServe subscribe to the greeks and volatilities of all the options of an instrument family, e.g. BTC-USD
+func WsOptionSummaryServe(instFamily string, handler WsOptionSummaryHandler, errHandler ErrHandler, simulated bool) (doneC, stopC chan struct{}, err error) {
+	endpoint := getWsEndpoint(false, simulated)
+	return wsOptionSummaryServe(endpoint, instFamily, handler, errHandler)
+}
+
+// WsOptionSummaryServe serve websocket
+func wsOptionSummaryServe(endpoint string, instFamily string, handler WsOptionSummaryHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+	arg := map[string]string{
+		"channel":    EVENT_BOOK_OPTION_SUMMARY.GetChannel(PERIOD_NONE),
+		"instFamily": instFamily,
+	}
+	var args []map[string]string
+	args = append(args, arg)
+	reqData := ReqData{Op: "subscribe",
+		Args: args,
+	}
+	cfg := newWsConfig(endpoint, reqData, "", "", "")
+	wsHandler := func(message []byte) {
+		event := new(WsOptionSummaryEvent)
+		err = json.Unmarshal(message, event)
+		if err != nil {
+			errHandler(err)
+			return
+		}
+
+		handler(event)
+	}
+	return wsServe(cfg, wsHandler, errHandler)
+}